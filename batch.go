@@ -0,0 +1,67 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// batchChunk holds the number of inputs a worker claims at once,
+// which keeps the shared counter off the hot path.
+const batchChunk = 64
+
+////////////////
+
+// HashBatch computes the hash of every inputs[i] and stores it in out[i],
+// fanning the work out over workers goroutines. Every worker reuses a
+// single Hash state. A workers value below one uses GOMAXPROCS workers.
+// When ctx is cancelled the remaining inputs are skipped and ctx.Err()
+// is returned, out is then only partially filled.
+func HashBatch(ctx context.Context, inputs [][]byte, out [][HashSize]byte, workers int) error {
+	if len(out) != len(inputs) {
+		return fmt.Errorf("HashBatch: out length: %d, expected %d", len(out), len(inputs))
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if lim := (len(inputs) + batchChunk - 1) / batchChunk; workers > lim {
+		workers = lim
+	}
+
+	var next int64
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ref := pool.Get().(*Hash)
+			defer pool.Put(ref)
+
+			for ctx.Err() == nil {
+				end := int(atomic.AddInt64(&next, batchChunk))
+				beg := end - batchChunk
+				if beg >= len(inputs) {
+					return
+				}
+				if end > len(inputs) {
+					end = len(inputs)
+				}
+
+				for i := beg; i < end; i++ {
+					ref.Hash(inputs[i], out[i][:])
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	return ctx.Err()
+}
@@ -0,0 +1,62 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+)
+
+func TestHashBatch(t *testing.T) {
+	inputs := make([][]byte, 1000)
+	for i := range inputs {
+		inputs[i] = make([]byte, len(hexBlockVerge))
+		copy(inputs[i], hexBlockVerge)
+		binary.LittleEndian.PutUint32(inputs[i][76:], uint32(i))
+	}
+
+	hs := New()
+	want := make([][HashSize]byte, len(inputs))
+	for i := range inputs {
+		hs.Hash(inputs[i], want[i][:])
+	}
+
+	for _, workers := range []int{0, 1, 3, 64} {
+		out := make([][HashSize]byte, len(inputs))
+		if err := HashBatch(context.Background(), inputs, out, workers); err != nil {
+			t.Fatalf("workers %d: unexpected error: %v", workers, err)
+		}
+
+		for i := range out {
+			if out[i] != want[i] {
+				t.Errorf("workers %d, input %d: expected: %X, got: %X", workers, i, want[i], out[i])
+			}
+		}
+	}
+}
+
+func TestHashBatchEmpty(t *testing.T) {
+	if err := HashBatch(context.Background(), nil, nil, 4); err != nil {
+		t.Errorf("expected nil error, got: %v", err)
+	}
+}
+
+func TestHashBatchLength(t *testing.T) {
+	out := make([][HashSize]byte, 1)
+	if nil == HashBatch(context.Background(), make([][]byte, 2), out, 1) {
+		t.Error("expected out length error, got: nil")
+	}
+}
+
+func TestHashBatchCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	inputs := make([][]byte, 1000)
+	out := make([][HashSize]byte, len(inputs))
+	if err := HashBatch(ctx, inputs, out, 4); err != context.Canceled {
+		t.Errorf("expected: %v, got: %v", context.Canceled, err)
+	}
+}
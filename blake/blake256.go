@@ -0,0 +1,227 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package blake
+
+import (
+	"fmt"
+
+	"github.com/rnichollx/go-x17/hash"
+)
+
+// HashSize256 holds the size of a BLAKE256 hash in bytes.
+const HashSize256 = int(32)
+
+// BlockSize256 holds the size of a BLAKE256 block in bytes.
+const BlockSize256 = uintptr(64)
+
+////////////////
+
+type digest256 struct {
+	ptr uintptr
+
+	h [8]uint32
+	t uint64
+
+	b [BlockSize256]byte
+}
+
+// New256 returns a new digest compute a BLAKE256 hash.
+func New256() hash.Digest {
+	ref := &digest256{}
+	ref.Reset()
+	return ref
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *digest256) Reset() {
+	ref.ptr = 0
+	copy(ref.h[:], kInit256[:])
+	ref.t = 0
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *digest256) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [32]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *digest256) Write(src []byte) (int, error) {
+	sln := uintptr(len(src))
+	fln := len(src)
+	ptr := ref.ptr
+
+	if sln < (BlockSize256 - ptr) {
+		copy(ref.b[ptr:], src)
+		ref.ptr += sln
+		return int(sln), nil
+	}
+
+	for sln > 0 {
+		cln := BlockSize256 - ptr
+
+		if cln > sln {
+			cln = sln
+		}
+		sln -= cln
+
+		copy(ref.b[ptr:], src[:cln])
+		src = src[cln:]
+		ptr += cln
+
+		if ptr == BlockSize256 {
+			ref.t += 512
+			ref.compress(ref.t)
+			ptr = 0
+		}
+	}
+
+	ref.ptr = ptr
+	return fln, nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize256 will return an error.
+func (ref *digest256) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); HashSize256 > ln {
+		return fmt.Errorf("Blake Close: dst min length: %d, got %d", HashSize256, ln)
+	}
+
+	ptr := ref.ptr
+	bln := uint64((ptr << 3) + uintptr(bcnt))
+	tln := ref.t + bln
+
+	{
+		off := uint8(0x80) >> bcnt
+		ref.b[ptr] = uint8((bits & -off) | off)
+	}
+	memset(ref.b[ptr+1:], 0)
+
+	// The counter of a block only covers message bits, a block made
+	// of padding alone is compressed with a zero counter.
+	if bln <= 446 {
+		ref.b[55] |= 1
+		encUInt64be(ref.b[56:], tln)
+		if bln == 0 {
+			ref.compress(0)
+		} else {
+			ref.compress(tln)
+		}
+	} else {
+		ref.compress(tln)
+
+		memset(ref.b[:], 0)
+		ref.b[55] = 1
+		encUInt64be(ref.b[56:], tln)
+		ref.compress(0)
+	}
+
+	for k := uintptr(0); k < 8; k++ {
+		encUInt32be(dst[(k<<2):], ref.h[k])
+	}
+
+	ref.Reset()
+	return nil
+}
+
+// Size returns the number of bytes Sum will return.
+func (*digest256) Size() int {
+	return HashSize256
+}
+
+// BlockSize returns the block size of the hash.
+func (*digest256) BlockSize() int {
+	return int(BlockSize256)
+}
+
+////////////////
+
+// compress runs the fourteen rounds of BLAKE256 over the buffered
+// block, cnt is the number of message bits up to the end of it.
+func (ref *digest256) compress(cnt uint64) {
+	h := &ref.h
+
+	var mat [16]uint32
+	for i := range mat {
+		mat[i] = decUInt32be(ref.b[i<<2:])
+	}
+
+	var vec [16]uint32
+	copy(vec[:8], h[:])
+	copy(vec[8:], kSpec256[:8])
+	vec[0xC] ^= uint32(cnt)
+	vec[0xD] ^= uint32(cnt)
+	vec[0xE] ^= uint32(cnt >> 32)
+	vec[0xF] ^= uint32(cnt >> 32)
+
+	for r := 0; r < 14; r++ {
+		sig := &kSigma[r%10]
+
+		mix256(&vec, &mat, sig, 0x0, 0x4, 0x8, 0xC, 0x0)
+		mix256(&vec, &mat, sig, 0x1, 0x5, 0x9, 0xD, 0x2)
+		mix256(&vec, &mat, sig, 0x2, 0x6, 0xA, 0xE, 0x4)
+		mix256(&vec, &mat, sig, 0x3, 0x7, 0xB, 0xF, 0x6)
+		mix256(&vec, &mat, sig, 0x0, 0x5, 0xA, 0xF, 0x8)
+		mix256(&vec, &mat, sig, 0x1, 0x6, 0xB, 0xC, 0xA)
+		mix256(&vec, &mat, sig, 0x2, 0x7, 0x8, 0xD, 0xC)
+		mix256(&vec, &mat, sig, 0x3, 0x4, 0x9, 0xE, 0xE)
+	}
+
+	for i := range h {
+		h[i] ^= vec[i] ^ vec[i+8]
+	}
+}
+
+func mix256(vec, mat *[16]uint32, sig *[16]uint8, a, b, c, d, i uint8) {
+	x, y := sig[i], sig[i+1]
+
+	vec[a] = vec[a] + vec[b] + (mat[x] ^ kSpec256[y])
+	vec[d] = ((vec[d] ^ vec[a]) << 16) | ((vec[d] ^ vec[a]) >> 16)
+	vec[c] = vec[c] + vec[d]
+	vec[b] = ((vec[b] ^ vec[c]) << 20) | ((vec[b] ^ vec[c]) >> 12)
+	vec[a] = vec[a] + vec[b] + (mat[y] ^ kSpec256[x])
+	vec[d] = ((vec[d] ^ vec[a]) << 24) | ((vec[d] ^ vec[a]) >> 8)
+	vec[c] = vec[c] + vec[d]
+	vec[b] = ((vec[b] ^ vec[c]) << 25) | ((vec[b] ^ vec[c]) >> 7)
+}
+
+func decUInt32be(src []byte) uint32 {
+	return (uint32(src[0])<<24 |
+		uint32(src[1])<<16 |
+		uint32(src[2])<<8 |
+		uint32(src[3]))
+}
+
+func encUInt32be(dst []byte, src uint32) {
+	dst[0] = uint8(src >> 24)
+	dst[1] = uint8(src >> 16)
+	dst[2] = uint8(src >> 8)
+	dst[3] = uint8(src)
+}
+
+////////////////
+
+var kInit256 = [8]uint32{
+	uint32(0x6A09E667), uint32(0xBB67AE85),
+	uint32(0x3C6EF372), uint32(0xA54FF53A),
+	uint32(0x510E527F), uint32(0x9B05688C),
+	uint32(0x1F83D9AB), uint32(0x5BE0CD19),
+}
+
+var kSpec256 = [16]uint32{
+	uint32(0x243F6A88), uint32(0x85A308D3),
+	uint32(0x13198A2E), uint32(0x03707344),
+	uint32(0xA4093822), uint32(0x299F31D0),
+	uint32(0x082EFA98), uint32(0xEC4E6C89),
+	uint32(0x452821E6), uint32(0x38D01377),
+	uint32(0xBE5466CF), uint32(0x34E90C6C),
+	uint32(0xC0AC29B7), uint32(0xC97C50DD),
+	uint32(0x3F84D5B5), uint32(0xB5470917),
+}
@@ -0,0 +1,386 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bmw
+
+import (
+	"fmt"
+
+	"github.com/rnichollx/go-x17/hash"
+)
+
+// HashSize256 holds the size of a BMW256 hash in bytes.
+const HashSize256 = uintptr(32)
+
+// BlockSize256 holds the size of a BMW256 block in bytes.
+const BlockSize256 = uintptr(64)
+
+////////////////
+
+type digest256 struct {
+	ptr uintptr
+	cnt uint64
+
+	h [16]uint32
+
+	b [BlockSize256]byte
+}
+
+// New256 returns a new digest compute a BMW256 hash.
+func New256() hash.Digest {
+	ref := &digest256{}
+	ref.Reset()
+	return ref
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *digest256) Reset() {
+	ref.ptr = 0
+	ref.cnt = 0
+	copy(ref.h[:], kInit256[:])
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *digest256) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [32]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *digest256) Write(src []byte) (int, error) {
+	sln := uintptr(len(src))
+	fln := len(src)
+	ptr := ref.ptr
+
+	ht := [16]uint32{}
+	h1 := ref.h[:]
+	h2 := ht[:]
+
+	ref.cnt += uint64(sln << 3)
+
+	for sln > 0 {
+		cln := BlockSize256 - ptr
+
+		if cln > sln {
+			cln = sln
+		}
+		sln -= cln
+
+		copy(ref.b[ptr:], src[:cln])
+		src = src[cln:]
+		ptr += cln
+
+		if ptr == BlockSize256 {
+			compress256(ref.b[:], h1, h2)
+			h1, h2 = h2[:], h1[:]
+			ptr = 0
+		}
+	}
+
+	copy(ref.h[:], h1[:])
+
+	ref.ptr = ptr
+	return fln, nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize256 will return an error.
+func (ref *digest256) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); int(HashSize256) > ln {
+		return fmt.Errorf("Bmw Close: dst min length: %d, got %d", HashSize256, ln)
+	}
+
+	buf := ref.b[:]
+	ptr := ref.ptr + 1
+
+	{
+		off := uint8(0x80) >> bcnt
+		buf[ref.ptr] = uint8((bits & -off) | off)
+	}
+
+	var h1, h2 [16]uint32
+	if ptr > (BlockSize256 - 8) {
+		memset(buf[ptr:], 0)
+		compress256(buf, ref.h[:], h1[:])
+		ref.h = h1
+		ptr = 0
+	}
+
+	memset(buf[ptr:(BlockSize256-8)], 0)
+	encUInt64le(buf[BlockSize256-8:], ref.cnt+uint64(bcnt))
+
+	compress256(buf, ref.h[:], h2[:])
+	for u := uint8(0); u < 16; u++ {
+		encUInt32le(buf[(u*4):], h2[u])
+	}
+
+	compress256(buf, kFinal256[:], h1[:])
+	for u := uint8(0); u < 8; u++ {
+		encUInt32le(dst[(u*4):], h1[8+u])
+	}
+
+	ref.Reset()
+	return nil
+}
+
+// Size returns the number of bytes required to store the hash.
+func (*digest256) Size() int {
+	return int(HashSize256)
+}
+
+// BlockSize returns the block size of the hash.
+func (*digest256) BlockSize() int {
+	return int(BlockSize256)
+}
+
+////////////////
+
+func compress256(src []uint8, hv, dh []uint32) {
+	var xl, xh uint32
+
+	mv := [16]uint32{}
+	qt := [32]uint32{}
+	mv[0x0] = decUInt32le(src[0:])
+	mv[0x1] = decUInt32le(src[4:])
+	mv[0x2] = decUInt32le(src[8:])
+	mv[0x3] = decUInt32le(src[12:])
+	mv[0x4] = decUInt32le(src[16:])
+	mv[0x5] = decUInt32le(src[20:])
+	mv[0x6] = decUInt32le(src[24:])
+	mv[0x7] = decUInt32le(src[28:])
+	mv[0x8] = decUInt32le(src[32:])
+	mv[0x9] = decUInt32le(src[36:])
+	mv[0xA] = decUInt32le(src[40:])
+	mv[0xB] = decUInt32le(src[44:])
+	mv[0xC] = decUInt32le(src[48:])
+	mv[0xD] = decUInt32le(src[52:])
+	mv[0xE] = decUInt32le(src[56:])
+	mv[0xF] = decUInt32le(src[60:])
+
+	{
+		xv := [16]uint32{}
+		xv[0x0] = mv[0x0] ^ hv[0x0]
+		xv[0x1] = mv[0x1] ^ hv[0x1]
+		xv[0x2] = mv[0x2] ^ hv[0x2]
+		xv[0x3] = mv[0x3] ^ hv[0x3]
+		xv[0x4] = mv[0x4] ^ hv[0x4]
+		xv[0x5] = mv[0x5] ^ hv[0x5]
+		xv[0x6] = mv[0x6] ^ hv[0x6]
+		xv[0x7] = mv[0x7] ^ hv[0x7]
+		xv[0x8] = mv[0x8] ^ hv[0x8]
+		xv[0x9] = mv[0x9] ^ hv[0x9]
+		xv[0xA] = mv[0xA] ^ hv[0xA]
+		xv[0xB] = mv[0xB] ^ hv[0xB]
+		xv[0xC] = mv[0xC] ^ hv[0xC]
+		xv[0xD] = mv[0xD] ^ hv[0xD]
+		xv[0xE] = mv[0xE] ^ hv[0xE]
+		xv[0xF] = mv[0xF] ^ hv[0xF]
+
+		qt[0] = hv[0x1] + shiftSmall0(
+			xv[0x5]-xv[0x7]+xv[0xA]+xv[0xD]+xv[0xE],
+		)
+		qt[1] = hv[0x2] + shiftSmall1(
+			xv[0x6]-xv[0x8]+xv[0xB]+xv[0xE]-xv[0xF],
+		)
+		qt[2] = hv[0x3] + shiftSmall2(
+			xv[0x0]+xv[0x7]+xv[0x9]-xv[0xC]+xv[0xF],
+		)
+		qt[3] = hv[0x4] + shiftSmall3(
+			xv[0x0]-xv[0x1]+xv[0x8]-xv[0xA]+xv[0xD],
+		)
+		qt[4] = hv[0x5] + shiftSmall4(
+			xv[0x1]+xv[0x2]+xv[0x9]-xv[0xB]-xv[0xE],
+		)
+		qt[5] = hv[0x6] + shiftSmall0(
+			xv[0x3]-xv[0x2]+xv[0xA]-xv[0xC]+xv[0xF],
+		)
+		qt[6] = hv[0x7] + shiftSmall1(
+			xv[0x4]-xv[0x0]-xv[0x3]-xv[0xB]+xv[0xD],
+		)
+		qt[7] = hv[0x8] + shiftSmall2(
+			xv[0x1]-xv[0x4]-xv[0x5]-xv[0xC]-xv[0xE],
+		)
+		qt[8] = hv[0x9] + shiftSmall3(
+			xv[0x2]-xv[0x5]-xv[0x6]+xv[0xD]-xv[0xF],
+		)
+		qt[9] = hv[0xA] + shiftSmall4(
+			xv[0x0]-xv[0x3]+xv[0x6]-xv[0x7]+xv[0xE],
+		)
+		qt[10] = hv[0xB] + shiftSmall0(
+			xv[0x8]-xv[0x1]-xv[0x4]-xv[0x7]+xv[0xF],
+		)
+		qt[11] = hv[0xC] + shiftSmall1(
+			xv[0x8]-xv[0x0]-xv[0x2]-xv[0x5]+xv[0x9],
+		)
+		qt[12] = hv[0xD] + shiftSmall2(
+			xv[0x1]+xv[0x3]-xv[0x6]-xv[0x9]+xv[0xA],
+		)
+		qt[13] = hv[0xE] + shiftSmall3(
+			xv[0x2]+xv[0x4]+xv[0x7]+xv[0xA]+xv[0xB],
+		)
+		qt[14] = hv[0xF] + shiftSmall4(
+			xv[0x3]-xv[0x5]+xv[0x8]-xv[0xB]-xv[0xC],
+		)
+		qt[15] = hv[0x0] + shiftSmall0(
+			xv[0xC]-xv[0x4]-xv[0x6]-xv[0x9]+xv[0xD],
+		)
+	}
+
+	qt[16] = expandOne256(16, qt[:], mv[:], hv[:])
+	qt[17] = expandOne256(17, qt[:], mv[:], hv[:])
+	qt[18] = expandTwo256(18, qt[:], mv[:], hv[:])
+	qt[19] = expandTwo256(19, qt[:], mv[:], hv[:])
+	qt[20] = expandTwo256(20, qt[:], mv[:], hv[:])
+	qt[21] = expandTwo256(21, qt[:], mv[:], hv[:])
+	qt[22] = expandTwo256(22, qt[:], mv[:], hv[:])
+	qt[23] = expandTwo256(23, qt[:], mv[:], hv[:])
+	qt[24] = expandTwo256(24, qt[:], mv[:], hv[:])
+	qt[25] = expandTwo256(25, qt[:], mv[:], hv[:])
+	qt[26] = expandTwo256(26, qt[:], mv[:], hv[:])
+	qt[27] = expandTwo256(27, qt[:], mv[:], hv[:])
+	qt[28] = expandTwo256(28, qt[:], mv[:], hv[:])
+	qt[29] = expandTwo256(29, qt[:], mv[:], hv[:])
+	qt[30] = expandTwo256(30, qt[:], mv[:], hv[:])
+	qt[31] = expandTwo256(31, qt[:], mv[:], hv[:])
+
+	xl = qt[16] ^ qt[17] ^ qt[18] ^ qt[19] ^ qt[20] ^ qt[21] ^ qt[22] ^ qt[23]
+	xh = xl ^ qt[24] ^ qt[25] ^ qt[26] ^ qt[27] ^ qt[28] ^ qt[29] ^ qt[30] ^ qt[31]
+
+	dh[0x0] = ((xh << 5) ^ (qt[16] >> 5) ^ mv[0x0]) + (xl ^ qt[24] ^ qt[0])
+	dh[0x1] = ((xh >> 7) ^ (qt[17] << 8) ^ mv[0x1]) + (xl ^ qt[25] ^ qt[1])
+	dh[0x2] = ((xh >> 5) ^ (qt[18] << 5) ^ mv[0x2]) + (xl ^ qt[26] ^ qt[2])
+	dh[0x3] = ((xh >> 1) ^ (qt[19] << 5) ^ mv[0x3]) + (xl ^ qt[27] ^ qt[3])
+	dh[0x4] = ((xh >> 3) ^ (qt[20] << 0) ^ mv[0x4]) + (xl ^ qt[28] ^ qt[4])
+	dh[0x5] = ((xh << 6) ^ (qt[21] >> 6) ^ mv[0x5]) + (xl ^ qt[29] ^ qt[5])
+	dh[0x6] = ((xh >> 4) ^ (qt[22] << 6) ^ mv[0x6]) + (xl ^ qt[30] ^ qt[6])
+	dh[0x7] = ((xh >> 11) ^ (qt[23] << 2) ^ mv[0x7]) + (xl ^ qt[31] ^ qt[7])
+
+	dh[0x8] = ((dh[0x4] << 9) | (dh[0x4] >> (32 - 9)))
+	dh[0x8] += (xh ^ qt[24] ^ mv[0x8]) + ((xl << 8) ^ qt[23] ^ qt[8])
+	dh[0x9] = ((dh[0x5] << 10) | (dh[0x5] >> (32 - 10)))
+	dh[0x9] += (xh ^ qt[25] ^ mv[0x9]) + ((xl >> 6) ^ qt[16] ^ qt[9])
+	dh[0xA] = ((dh[0x6] << 11) | (dh[0x6] >> (32 - 11)))
+	dh[0xA] += (xh ^ qt[26] ^ mv[0xA]) + ((xl << 6) ^ qt[17] ^ qt[10])
+	dh[0xB] = ((dh[0x7] << 12) | (dh[0x7] >> (32 - 12)))
+	dh[0xB] += (xh ^ qt[27] ^ mv[0xB]) + ((xl << 4) ^ qt[18] ^ qt[11])
+	dh[0xC] = ((dh[0x0] << 13) | (dh[0x0] >> (32 - 13)))
+	dh[0xC] += (xh ^ qt[28] ^ mv[0xC]) + ((xl >> 3) ^ qt[19] ^ qt[12])
+	dh[0xD] = ((dh[0x1] << 14) | (dh[0x1] >> (32 - 14)))
+	dh[0xD] += (xh ^ qt[29] ^ mv[0xD]) + ((xl >> 4) ^ qt[20] ^ qt[13])
+	dh[0xE] = ((dh[0x2] << 15) | (dh[0x2] >> (32 - 15)))
+	dh[0xE] += (xh ^ qt[30] ^ mv[0xE]) + ((xl >> 7) ^ qt[21] ^ qt[14])
+	dh[0xF] = ((dh[0x3] << 16) | (dh[0x3] >> (32 - 16)))
+	dh[0xF] += (xh ^ qt[31] ^ mv[0xF]) + ((xl >> 2) ^ qt[22] ^ qt[15])
+}
+
+func decUInt32le(src []byte) uint32 {
+	return (uint32(src[0]) |
+		uint32(src[1])<<8 |
+		uint32(src[2])<<16 |
+		uint32(src[3])<<24)
+}
+
+func encUInt32le(dst []byte, src uint32) {
+	dst[0] = uint8(src)
+	dst[1] = uint8(src >> 8)
+	dst[2] = uint8(src >> 16)
+	dst[3] = uint8(src >> 24)
+}
+
+func shiftSmall0(x uint32) uint32 {
+	return ((x >> 1) ^ (x << 3) ^
+		((x << 4) | (x >> (32 - 4))) ^
+		((x << 19) | (x >> (32 - 19))))
+}
+
+func shiftSmall1(x uint32) uint32 {
+	return ((x >> 1) ^ (x << 2) ^
+		((x << 8) | (x >> (32 - 8))) ^
+		((x << 23) | (x >> (32 - 23))))
+}
+
+func shiftSmall2(x uint32) uint32 {
+	return ((x >> 2) ^ (x << 1) ^
+		((x << 12) | (x >> (32 - 12))) ^
+		((x << 25) | (x >> (32 - 25))))
+}
+
+func shiftSmall3(x uint32) uint32 {
+	return ((x >> 2) ^ (x << 2) ^
+		((x << 15) | (x >> (32 - 15))) ^
+		((x << 29) | (x >> (32 - 29))))
+}
+
+func shiftSmall4(x uint32) uint32 {
+	return ((x >> 1) ^ x)
+}
+
+func shiftSmall5(x uint32) uint32 {
+	return ((x >> 2) ^ x)
+}
+
+func rolBits256(idx, off uint8, mv []uint32) uint32 {
+	x := mv[(idx+off)&15]
+	n := uint32((idx+off)&15) + 1
+	return (x << n) | (x >> (32 - n))
+}
+
+func addEltBits256(idx uint8, mv, hv []uint32) uint32 {
+	kbt := uint32(idx+16) * uint32(0x05555555)
+	return ((rolBits256(idx, 0, mv) + rolBits256(idx, 3, mv) -
+		rolBits256(idx, 10, mv) + kbt) ^ (hv[(idx+7)&15]))
+}
+
+func expandOne256(idx uint8, qt, mv, hv []uint32) uint32 {
+	return (shiftSmall1(qt[idx-0x10]) + shiftSmall2(qt[idx-0x0F]) +
+		shiftSmall3(qt[idx-0x0E]) + shiftSmall0(qt[idx-0x0D]) +
+		shiftSmall1(qt[idx-0x0C]) + shiftSmall2(qt[idx-0x0B]) +
+		shiftSmall3(qt[idx-0x0A]) + shiftSmall0(qt[idx-0x09]) +
+		shiftSmall1(qt[idx-0x08]) + shiftSmall2(qt[idx-0x07]) +
+		shiftSmall3(qt[idx-0x06]) + shiftSmall0(qt[idx-0x05]) +
+		shiftSmall1(qt[idx-0x04]) + shiftSmall2(qt[idx-0x03]) +
+		shiftSmall3(qt[idx-0x02]) + shiftSmall0(qt[idx-0x01]) +
+		addEltBits256(uint8(idx-16), mv, hv))
+}
+
+func expandTwo256(idx uint8, qt, mv, hv []uint32) uint32 {
+	return (qt[idx-0x10] + ((qt[idx-0x0F] << 3) | (qt[idx-0x0F] >> (32 - 3))) +
+		qt[idx-0x0E] + ((qt[idx-0x0D] << 7) | (qt[idx-0x0D] >> (32 - 7))) +
+		qt[idx-0x0C] + ((qt[idx-0x0B] << 13) | (qt[idx-0x0B] >> (32 - 13))) +
+		qt[idx-0x0A] + ((qt[idx-0x09] << 16) | (qt[idx-0x09] >> (32 - 16))) +
+		qt[idx-0x08] + ((qt[idx-0x07] << 19) | (qt[idx-0x07] >> (32 - 19))) +
+		qt[idx-0x06] + ((qt[idx-0x05] << 23) | (qt[idx-0x05] >> (32 - 23))) +
+		qt[idx-0x04] + ((qt[idx-0x03] << 27) | (qt[idx-0x03] >> (32 - 27))) +
+		shiftSmall4(qt[idx-0x02]) + shiftSmall5(qt[idx-0x01]) +
+		addEltBits256(uint8(idx-16), mv, hv))
+}
+
+////////////////
+
+var kInit256 = [16]uint32{
+	uint32(0x40414243), uint32(0x44454647),
+	uint32(0x48494A4B), uint32(0x4C4D4E4F),
+	uint32(0x50515253), uint32(0x54555657),
+	uint32(0x58595A5B), uint32(0x5C5D5E5F),
+	uint32(0x60616263), uint32(0x64656667),
+	uint32(0x68696A6B), uint32(0x6C6D6E6F),
+	uint32(0x70717273), uint32(0x74757677),
+	uint32(0x78797A7B), uint32(0x7C7D7E7F),
+}
+
+var kFinal256 = [16]uint32{
+	uint32(0xaaaaaaa0), uint32(0xaaaaaaa1),
+	uint32(0xaaaaaaa2), uint32(0xaaaaaaa3),
+	uint32(0xaaaaaaa4), uint32(0xaaaaaaa5),
+	uint32(0xaaaaaaa6), uint32(0xaaaaaaa7),
+	uint32(0xaaaaaaa8), uint32(0xaaaaaaa9),
+	uint32(0xaaaaaaaa), uint32(0xaaaaaaab),
+	uint32(0xaaaaaaac), uint32(0xaaaaaaad),
+	uint32(0xaaaaaaae), uint32(0xaaaaaaaf),
+}
@@ -0,0 +1,79 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package bmw
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+////////////////
+
+func TestApi256(t *testing.T) {
+	dgst := New256()
+	if sz := dgst.Size(); int(HashSize256) != sz {
+		t.Errorf("Size: expected: %d, got: %d", int(HashSize256), sz)
+	}
+	if sz := dgst.BlockSize(); int(BlockSize256) != sz {
+		t.Errorf("BlockSize: expected: %d, got: %d", BlockSize256, sz)
+	}
+	res := [2]byte{}
+	if nil == dgst.Close(res[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
+func TestHash256(t *testing.T) {
+	dgst := New256()
+	for i := range k256Result {
+		src, _ := hex.DecodeString(k256Result[i].in)
+		hash, _ := hex.DecodeString(k256Result[i].out)
+
+		dgst.Write(src)
+		if res := dgst.Sum(nil); !bytes.Equal(hash, res) {
+			t.Errorf("\nSum %d:\n expected: %X\n      got: %X", i, hash, res)
+		}
+
+		// Close resets the digest for the next round.
+		res := [32]byte{}
+		dgst.Close(res[:], 0, 0)
+		if !bytes.Equal(hash, res[:]) {
+			t.Errorf("\nClose %d:\n expected: %X\n      got: %X", i, hash, res)
+		}
+	}
+}
+
+func TestWrite256(t *testing.T) {
+	src := make([]byte, 3*BlockSize256+5)
+	for i := range src {
+		src[i] = byte(i * 7)
+	}
+
+	dgst := New256()
+	dgst.Write(src)
+	want := dgst.Sum(nil)
+
+	for n := 0; n <= len(src); n += 13 {
+		dgst.Reset()
+		dgst.Write(src[:n])
+		dgst.Write(src[n:])
+		if got := dgst.Sum(nil); !bytes.Equal(want, got) {
+			t.Errorf("split at %d:\n expected: %X\n      got: %X", n, want, got)
+		}
+	}
+}
+
+////////////////
+
+// k256Result holds BMW256 digests of hex encoded messages.
+var k256Result = []struct {
+	in  string
+	out string
+}{
+	{
+		"",
+		"82CAC4BF6F4C2B41FBCC0E0984E9D8B76D7662F8E1789CDFBD85682ACC55577A",
+	},
+}
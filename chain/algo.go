@@ -0,0 +1,135 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+import (
+	"crypto/sha512"
+	"fmt"
+	stdhash "hash"
+
+	"github.com/rnichollx/go-x17/blake"
+	"github.com/rnichollx/go-x17/bmw"
+	"github.com/rnichollx/go-x17/cubed"
+	"github.com/rnichollx/go-x17/echo"
+	"github.com/rnichollx/go-x17/fugue"
+	"github.com/rnichollx/go-x17/gost"
+	"github.com/rnichollx/go-x17/groest"
+	"github.com/rnichollx/go-x17/hamsi"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/jhash"
+	"github.com/rnichollx/go-x17/keccak"
+	"github.com/rnichollx/go-x17/luffa"
+	"github.com/rnichollx/go-x17/shabal"
+	"github.com/rnichollx/go-x17/shavite"
+	"github.com/rnichollx/go-x17/simd"
+	"github.com/rnichollx/go-x17/skein"
+	"github.com/rnichollx/go-x17/whirlpool_x17"
+)
+
+// Algo identifies one of the 512 bit digests a chain is built from.
+type Algo int
+
+// The digests available as stages of a chain.
+const (
+	Blake Algo = iota
+	BMW
+	Groestl
+	Skein
+	JH
+	Keccak
+	Luffa
+	CubeHash
+	Shavite
+	SIMD
+	Echo
+	Hamsi
+	Fugue
+	Shabal
+	Whirlpool
+	SHA512
+	GOST
+
+	algoCount
+)
+
+var algoNames = [algoCount]string{
+	"blake", "bmw", "groestl", "skein", "jh", "keccak", "luffa",
+	"cubehash", "shavite", "simd", "echo", "hamsi", "fugue", "shabal",
+	"whirlpool", "sha512", "gost",
+}
+
+// String returns the lower case name of the digest.
+func (a Algo) String() string {
+	if a < 0 || a >= algoCount {
+		return fmt.Sprintf("Algo(%d)", int(a))
+	}
+	return algoNames[a]
+}
+
+// New returns a new digest for the algorithm, or nil
+// if a is not one of the declared constants.
+func (a Algo) New() hash.Digest {
+	switch a {
+	case Blake:
+		return blake.New()
+	case BMW:
+		return bmw.New()
+	case Groestl:
+		return groest.New()
+	case Skein:
+		return skein.New()
+	case JH:
+		return jhash.New()
+	case Keccak:
+		return keccak.New()
+	case Luffa:
+		return luffa.New()
+	case CubeHash:
+		return cubed.New()
+	case Shavite:
+		return shavite.New()
+	case SIMD:
+		return simd.New()
+	case Echo:
+		return echo.New()
+	case Hamsi:
+		return hamsi.New()
+	case Fugue:
+		return fugue.New()
+	case Shabal:
+		return shabal.New()
+	case Whirlpool:
+		return &std{whirlpool_x17.New(), "Whirlpool"}
+	case SHA512:
+		return &std{sha512.New(), "SHA512"}
+	case GOST:
+		return gost.New512LE()
+	}
+	return nil
+}
+
+////////////////
+
+// std adapts a standard library style hash.Hash
+// with a 64 byte output to a hash.Digest.
+type std struct {
+	stdhash.Hash
+
+	name string
+}
+
+// Close stores the hash in dst and resets the digest. Trailing
+// bits are not supported, a bcnt other than zero returns an error.
+func (ref *std) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); 64 > ln {
+		return fmt.Errorf("%s Close: dst min length: %d, got %d", ref.name, 64, ln)
+	}
+	if bcnt != 0 {
+		return fmt.Errorf("%s Close: trailing bits not supported, got %d", ref.name, bcnt)
+	}
+
+	ref.Hash.Sum(dst[:0])
+	ref.Hash.Reset()
+	return nil
+}
@@ -0,0 +1,138 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+// X11 returns the stages of the X11 hash.
+func X11() []Algo { return clone(x11) }
+
+// X13 returns the stages of the X13 hash.
+func X13() []Algo { return clone(x13) }
+
+// X14 returns the stages of the X14 hash.
+func X14() []Algo { return clone(x14) }
+
+// X15 returns the stages of the X15 hash.
+func X15() []Algo { return clone(x15) }
+
+// C11 returns the stages of the C11 hash, X11 with
+// JH and Keccak moved ahead of Skein.
+func C11() []Algo { return clone(c11) }
+
+// Phi1612 returns the stages of the Phi1612 hash.
+func Phi1612() []Algo { return clone(phi1612) }
+
+// Skunk returns the stages of the Skunk hash.
+func Skunk() []Algo { return clone(skunk) }
+
+// Tribus returns the stages of the Tribus hash.
+func Tribus() []Algo { return clone(tribus) }
+
+// Fresh returns the stages of the Fresh hash.
+func Fresh() []Algo { return clone(fresh) }
+
+// Deep returns the stages of the Deep hash.
+func Deep() []Algo { return clone(deep) }
+
+// clone returns a copy of algo, so callers can not alter the
+// stages of the chains declared here.
+func clone(algo []Algo) []Algo {
+	return append([]Algo(nil), algo...)
+}
+
+////////////////
+
+var x11 = []Algo{
+	Blake, BMW, Groestl, Skein, JH, Keccak,
+	Luffa, CubeHash, Shavite, SIMD, Echo,
+}
+
+var x13 = append(x11[:len(x11):len(x11)], Hamsi, Fugue)
+
+var x14 = append(x13[:len(x13):len(x13)], Shabal)
+
+var x15 = append(x14[:len(x14):len(x14)], Whirlpool)
+
+var c11 = []Algo{
+	Blake, BMW, Groestl, JH, Keccak, Skein,
+	Luffa, CubeHash, Shavite, SIMD, Echo,
+}
+
+var phi1612 = []Algo{
+	Skein, JH, CubeHash, Fugue, GOST, Echo,
+}
+
+var skunk = []Algo{
+	Skein, CubeHash, Fugue, GOST,
+}
+
+var tribus = []Algo{
+	JH, Keccak, Echo,
+}
+
+var fresh = []Algo{
+	Shavite, SIMD, Shavite, SIMD, Echo,
+}
+
+var deep = []Algo{
+	Luffa, CubeHash, Echo,
+}
+
+////////////////
+
+// NewX11 returns a new Chain to compute a X11 hash.
+func NewX11() *Chain {
+	return must(New(x11...))
+}
+
+// NewX13 returns a new Chain to compute a X13 hash.
+func NewX13() *Chain {
+	return must(New(x13...))
+}
+
+// NewX14 returns a new Chain to compute a X14 hash.
+func NewX14() *Chain {
+	return must(New(x14...))
+}
+
+// NewX15 returns a new Chain to compute a X15 hash.
+func NewX15() *Chain {
+	return must(New(x15...))
+}
+
+// NewC11 returns a new Chain to compute a C11 hash.
+func NewC11() *Chain {
+	return must(New(c11...))
+}
+
+// NewPhi1612 returns a new Chain to compute a Phi1612 hash.
+func NewPhi1612() *Chain {
+	return must(New(phi1612...))
+}
+
+// NewSkunk returns a new Chain to compute a Skunk hash.
+func NewSkunk() *Chain {
+	return must(New(skunk...))
+}
+
+// NewTribus returns a new Chain to compute a Tribus hash.
+func NewTribus() *Chain {
+	return must(New(tribus...))
+}
+
+// NewFresh returns a new Chain to compute a Fresh hash.
+func NewFresh() *Chain {
+	return must(New(fresh...))
+}
+
+// NewDeep returns a new Chain to compute a Deep hash.
+func NewDeep() *Chain {
+	return must(New(deep...))
+}
+
+func must(ref *Chain, err error) *Chain {
+	if err != nil {
+		panic(err)
+	}
+	return ref
+}
@@ -0,0 +1,88 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package chain runs a declared sequence of 512 bit digests, each
+// one hashing the output of the previous, as used by X11 and friends.
+package chain
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/hash"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("chain: dst shorter than HashSize")
+
+////////////////
+
+// Chain contains the state objects required to run
+// a fixed sequence of digests.
+type Chain struct {
+	tha [64]byte
+	thb [64]byte
+
+	algo []Algo
+	dgst []hash.Digest
+}
+
+// New returns a new Chain running the given digests in order,
+// it fails if algo is empty or holds an unknown Algo.
+func New(algo ...Algo) (*Chain, error) {
+	if len(algo) == 0 {
+		return nil, errors.New("chain: no stages")
+	}
+
+	ref := &Chain{
+		algo: append([]Algo(nil), algo...),
+		dgst: make([]hash.Digest, len(algo)),
+	}
+	for i, a := range algo {
+		if ref.dgst[i] = a.New(); ref.dgst[i] == nil {
+			return nil, fmt.Errorf("chain: unknown stage %v", a)
+		}
+	}
+
+	return ref, nil
+}
+
+// Algos returns the stages of the chain in order.
+func (ref *Chain) Algos() []Algo {
+	return append([]Algo(nil), ref.algo...)
+}
+
+// Hash computes the hash from the src bytes and stores the result
+// in dst, errors are dropped and leave dst untouched.
+func (ref *Chain) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the first
+// HashSize bytes of the last stage in dst. A call to Compute with
+// a dst that is smaller then HashSize returns ErrShortDst.
+func (ref *Chain) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	buf := [2][]byte{ref.tha[:], ref.thb[:]}
+
+	ta := src
+	for i, dgst := range ref.dgst {
+		tb := buf[i&1]
+
+		dgst.Reset()
+		dgst.Write(ta)
+		if err := dgst.Close(tb, 0, 0); err != nil {
+			return fmt.Errorf("chain: stage %v: %w", ref.algo[i], err)
+		}
+		ta = tb
+	}
+
+	copy(dst, ta[:HashSize])
+	return nil
+}
@@ -0,0 +1,47 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package chain
+
+////////////////
+
+// Pow runs a Chain as the proof-of-work hash of a package built on this
+// one, like qubit. Unlike Chain its result is byte swapped like x17.Hash
+// so it reads as block explorers show it.
+type Pow struct {
+	tmp [HashSize]byte
+
+	chain *Chain
+	short error
+}
+
+// NewPow returns a new Pow running the given digests in order, a call
+// to Compute with a dst that is smaller then HashSize returns short.
+// Like NewX11 it panics if algo is empty or holds an unknown Algo.
+func NewPow(short error, algo ...Algo) *Pow {
+	return &Pow{chain: must(New(algo...)), short: short}
+}
+
+// Hash computes the hash from the src bytes and stores the result
+// in dst, errors are dropped and leave dst untouched.
+func (ref *Pow) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the first
+// HashSize bytes of the last stage in dst, byte swapped. A call to
+// Compute with a dst that is smaller then HashSize returns the error
+// given to NewPow, a failing stage returns the error of Chain.Compute.
+func (ref *Pow) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ref.short
+	}
+
+	if err := ref.chain.Compute(src, ref.tmp[:]); err != nil {
+		return err
+	}
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ref.tmp[HashSize-1-i]
+	}
+	return nil
+}
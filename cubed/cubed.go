@@ -18,7 +18,8 @@ const BlockSize = uintptr(32)
 ////////////////
 
 type digest struct {
-	ptr uintptr
+	ptr  uintptr
+	size int
 
 	h [32]uint32
 
@@ -28,6 +29,15 @@ type digest struct {
 // New returns a new digest compute a CUBEHASH512 hash.
 func New() hash.Digest {
 	ref := &digest{}
+	ref.size = 64
+	ref.Reset()
+	return ref
+}
+
+// New256 returns a new digest compute a CUBEHASH256 hash.
+func New256() hash.Digest {
+	ref := &digest{}
+	ref.size = 32
 	ref.Reset()
 	return ref
 }
@@ -37,7 +47,14 @@ func New() hash.Digest {
 // Reset resets the digest to its initial state.
 func (ref *digest) Reset() {
 	ref.ptr = 0
-	copy(ref.h[:], kInit[:])
+	switch ref.size {
+	case 32:
+		copy(ref.h[:], kInit256[:])
+	case 64:
+		copy(ref.h[:], kInit[:])
+	default:
+		panic("wrong digest size")
+	}
 }
 
 // Sum appends the current hash to dst and returns the result
@@ -46,7 +63,7 @@ func (ref *digest) Sum(dst []byte) []byte {
 	dgt := *ref
 	hsh := [64]byte{}
 	dgt.Close(hsh[:], 0, 0)
-	return append(dst, hsh[:]...)
+	return append(dst, hsh[:ref.size]...)
 }
 
 // Write more data to the running hash, never returns an error.
@@ -107,10 +124,10 @@ func (ref *digest) Write(src []byte) (int, error) {
 
 // Close the digest by writing the last bits and storing the hash
 // in dst. This prepares the digest for reuse by calling reset. A call
-// to Close with a dst that is smaller then HashSize will return an error.
+// to Close with a dst that is smaller then Size will return an error.
 func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
-	if ln := len(dst); HashSize > ln {
-		return fmt.Errorf("Cubed Close: dst min length: %d, got %d", HashSize, ln)
+	if ln := len(dst); ref.size > ln {
+		return fmt.Errorf("Cubed Close: dst min length: %d, got %d", ref.size, ln)
 	}
 	st := ref.h[:]
 
@@ -150,7 +167,7 @@ func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
 		}
 	}
 
-	for i := uint8(0); i < 16; i++ {
+	for i := 0; i < ref.size>>2; i++ {
 		encUInt32le(dst[(i<<2):], ref.h[i])
 	}
 
@@ -159,8 +176,8 @@ func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
 }
 
 // Size returns the number of bytes required to store the hash.
-func (*digest) Size() int {
-	return int(HashSize)
+func (ref *digest) Size() int {
+	return ref.size
 }
 
 // BlockSize returns the block size of the hash.
@@ -401,3 +418,17 @@ var kInit = [32]uint32{
 	uint32(0xBC796576), uint32(0x1921C8F7), uint32(0xE7989AF1),
 	uint32(0x7795D246), uint32(0xD43E3B44),
 }
+
+var kInit256 = [32]uint32{
+	uint32(0xEA2BD4B4), uint32(0xCCD6F29F), uint32(0x63117E71),
+	uint32(0x35481EAE), uint32(0x22512D5B), uint32(0xE5D94E63),
+	uint32(0x7E624131), uint32(0xF4CC12BE), uint32(0xC2D0B696),
+	uint32(0x42AF2070), uint32(0xD0720C35), uint32(0x3361DA8C),
+	uint32(0x28CCECA4), uint32(0x8EF8AD83), uint32(0x4680AC00),
+	uint32(0x40E5FBAB), uint32(0xD89041C3), uint32(0x6107FBD5),
+	uint32(0x6C859D41), uint32(0xF0B26679), uint32(0x09392549),
+	uint32(0x5FA25603), uint32(0x65C892FD), uint32(0x93CB6285),
+	uint32(0x2AF2B5AE), uint32(0x9E4B4E60), uint32(0x774ABFDD),
+	uint32(0x85254725), uint32(0x15815AEB), uint32(0x4AB6AAD6),
+	uint32(0x9CDAF8AF), uint32(0xD6032C0A),
+}
@@ -0,0 +1,49 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import "errors"
+
+var (
+	// ErrShortDst is returned when dst can not hold HashSize bytes.
+	ErrShortDst = errors.New("x17: dst shorter than HashSize")
+
+	// ErrShortDigest is returned when the last stage yields
+	// less than the 32 bytes needed for the final byte swap.
+	ErrShortDigest = errors.New("x17: digest shorter than 32 bytes")
+
+	// ErrShortStageDst is returned by RunStage when dst
+	// can not hold the 64 bytes of a stage.
+	ErrShortStageDst = errors.New("x17: dst shorter than 64 bytes")
+
+	// ErrUnknownStage is returned, wrapped with the name,
+	// by RunStage for a name missing from StageNames.
+	ErrUnknownStage = errors.New("x17: unknown stage")
+
+	// ErrStage matches every *StageError when used with errors.Is.
+	ErrStage = errors.New("x17: stage failed")
+)
+
+////////////////
+
+// StageError records the failure of a single stage of the chain.
+type StageError struct {
+	Stage string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e *StageError) Error() string {
+	return "x17: stage " + e.Stage + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error reported by the stage.
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrStage.
+func (e *StageError) Is(target error) bool {
+	return target == ErrStage
+}
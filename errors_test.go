@@ -0,0 +1,65 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/rnichollx/go-x17/hash"
+)
+
+func TestComputeShortDst(t *testing.T) {
+	hs := New()
+	dst := make([]byte, HashSize-1)
+
+	if err := hs.Compute(hexBlockVerge, dst); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+
+	hs.Hash(hexBlockVerge, dst)
+	if !bytes.Equal(dst, make([]byte, HashSize-1)) {
+		t.Errorf("Hash: expected dst to be left untouched, got: %X", dst)
+	}
+}
+
+func TestCompute(t *testing.T) {
+	hs := New()
+	res := [HashSize]byte{}
+	out := [HashSize]byte{}
+
+	if err := hs.Compute(hexBlockVerge, res[:]); err != nil {
+		t.Fatalf("Compute: %v", err)
+	}
+	hs.Hash(hexBlockVerge, out[:])
+
+	if res != out {
+		t.Errorf("Compute: expected: %X, got: %X", out, res)
+	}
+}
+
+// failDigest is a stage whose Close always fails.
+type failDigest struct {
+	hash.Digest
+}
+
+func (failDigest) Close([]byte, uint8, uint8) error {
+	return errors.New("broken")
+}
+
+func TestComputeStageError(t *testing.T) {
+	hs := New()
+	hs.echo = failDigest{hs.echo}
+
+	err := hs.Compute(hexBlockVerge, make([]byte, HashSize))
+	if !errors.Is(err, ErrStage) {
+		t.Fatalf("Compute: expected ErrStage, got: %v", err)
+	}
+
+	var se *StageError
+	if !errors.As(err, &se) || se.Stage != "echo" {
+		t.Errorf("Compute: expected a StageError for echo, got: %v", err)
+	}
+}
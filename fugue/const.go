@@ -0,0 +1,272 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package fugue
+
+var kMix0 = [256]uint32{
+	0x63633297, 0x7C7C6FEB, 0x77775EC7, 0x7B7B7AF7,
+	0xF2F2E8E5, 0x6B6B0AB7, 0x6F6F16A7, 0xC5C56D39,
+	0x303090C0, 0x01010704, 0x67672E87, 0x2B2BD1AC,
+	0xFEFECCD5, 0xD7D71371, 0xABAB7C9A, 0x767659C3,
+	0xCACA4005, 0x8282A33E, 0xC9C94909, 0x7D7D68EF,
+	0xFAFAD0C5, 0x5959947F, 0x4747CE07, 0xF0F0E6ED,
+	0xADAD6E82, 0xD4D41A7D, 0xA2A243BE, 0xAFAF608A,
+	0x9C9CF946, 0xA4A451A6, 0x727245D3, 0xC0C0762D,
+	0xB7B728EA, 0xFDFDC5D9, 0x9393D47A, 0x2626F298,
+	0x363682D8, 0x3F3FBDFC, 0xF7F7F3F1, 0xCCCC521D,
+	0x34348CD0, 0xA5A556A2, 0xE5E58DB9, 0xF1F1E1E9,
+	0x71714CDF, 0xD8D83E4D, 0x313197C4, 0x15156B54,
+	0x04041C10, 0xC7C76331, 0x2323E98C, 0xC3C37F21,
+	0x18184860, 0x9696CF6E, 0x05051B14, 0x9A9AEB5E,
+	0x0707151C, 0x12127E48, 0x8080AD36, 0xE2E298A5,
+	0xEBEBA781, 0x2727F59C, 0xB2B233FE, 0x757550CF,
+	0x09093F24, 0x8383A43A, 0x2C2CC4B0, 0x1A1A4668,
+	0x1B1B416C, 0x6E6E11A3, 0x5A5A9D73, 0xA0A04DB6,
+	0x5252A553, 0x3B3BA1EC, 0xD6D61475, 0xB3B334FA,
+	0x2929DFA4, 0xE3E39FA1, 0x2F2FCDBC, 0x8484B126,
+	0x5353A257, 0xD1D10169, 0x00000000, 0xEDEDB599,
+	0x2020E080, 0xFCFCC2DD, 0xB1B13AF2, 0x5B5B9A77,
+	0x6A6A0DB3, 0xCBCB4701, 0xBEBE17CE, 0x3939AFE4,
+	0x4A4AED33, 0x4C4CFF2B, 0x5858937B, 0xCFCF5B11,
+	0xD0D0066D, 0xEFEFBB91, 0xAAAA7B9E, 0xFBFBD7C1,
+	0x4343D217, 0x4D4DF82F, 0x333399CC, 0x8585B622,
+	0x4545C00F, 0xF9F9D9C9, 0x02020E08, 0x7F7F66E7,
+	0x5050AB5B, 0x3C3CB4F0, 0x9F9FF04A, 0xA8A87596,
+	0x5151AC5F, 0xA3A344BA, 0x4040DB1B, 0x8F8F800A,
+	0x9292D37E, 0x9D9DFE42, 0x3838A8E0, 0xF5F5FDF9,
+	0xBCBC19C6, 0xB6B62FEE, 0xDADA3045, 0x2121E784,
+	0x10107040, 0xFFFFCBD1, 0xF3F3EFE1, 0xD2D20865,
+	0xCDCD5519, 0x0C0C2430, 0x1313794C, 0xECECB29D,
+	0x5F5F8667, 0x9797C86A, 0x4444C70B, 0x1717655C,
+	0xC4C46A3D, 0xA7A758AA, 0x7E7E61E3, 0x3D3DB3F4,
+	0x6464278B, 0x5D5D886F, 0x19194F64, 0x737342D7,
+	0x60603B9B, 0x8181AA32, 0x4F4FF627, 0xDCDC225D,
+	0x2222EE88, 0x2A2AD6A8, 0x9090DD76, 0x88889516,
+	0x4646C903, 0xEEEEBC95, 0xB8B805D6, 0x14146C50,
+	0xDEDE2C55, 0x5E5E8163, 0x0B0B312C, 0xDBDB3741,
+	0xE0E096AD, 0x32329EC8, 0x3A3AA6E8, 0x0A0A3628,
+	0x4949E43F, 0x06061218, 0x2424FC90, 0x5C5C8F6B,
+	0xC2C27825, 0xD3D30F61, 0xACAC6986, 0x62623593,
+	0x9191DA72, 0x9595C662, 0xE4E48ABD, 0x797974FF,
+	0xE7E783B1, 0xC8C84E0D, 0x373785DC, 0x6D6D18AF,
+	0x8D8D8E02, 0xD5D51D79, 0x4E4EF123, 0xA9A97292,
+	0x6C6C1FAB, 0x5656B943, 0xF4F4FAFD, 0xEAEAA085,
+	0x6565208F, 0x7A7A7DF3, 0xAEAE678E, 0x08083820,
+	0xBABA0BDE, 0x787873FB, 0x2525FB94, 0x2E2ECAB8,
+	0x1C1C5470, 0xA6A65FAE, 0xB4B421E6, 0xC6C66435,
+	0xE8E8AE8D, 0xDDDD2559, 0x747457CB, 0x1F1F5D7C,
+	0x4B4BEA37, 0xBDBD1EC2, 0x8B8B9C1A, 0x8A8A9B1E,
+	0x70704BDB, 0x3E3EBAF8, 0xB5B526E2, 0x66662983,
+	0x4848E33B, 0x0303090C, 0xF6F6F4F5, 0x0E0E2A38,
+	0x61613C9F, 0x35358BD4, 0x5757BE47, 0xB9B902D2,
+	0x8686BF2E, 0xC1C17129, 0x1D1D5374, 0x9E9EF74E,
+	0xE1E191A9, 0xF8F8DECD, 0x9898E556, 0x11117744,
+	0x696904BF, 0xD9D93949, 0x8E8E870E, 0x9494C166,
+	0x9B9BEC5A, 0x1E1E5A78, 0x8787B82A, 0xE9E9A989,
+	0xCECE5C15, 0x5555B04F, 0x2828D8A0, 0xDFDF2B51,
+	0x8C8C8906, 0xA1A14AB2, 0x89899212, 0x0D0D2334,
+	0xBFBF10CA, 0xE6E684B5, 0x4242D513, 0x686803BB,
+	0x4141DC1F, 0x9999E252, 0x2D2DC3B4, 0x0F0F2D3C,
+	0xB0B03DF6, 0x5454B74B, 0xBBBB0CDA, 0x16166258,
+}
+
+var kMix1 = [256]uint32{
+	0x97636332, 0xEB7C7C6F, 0xC777775E, 0xF77B7B7A,
+	0xE5F2F2E8, 0xB76B6B0A, 0xA76F6F16, 0x39C5C56D,
+	0xC0303090, 0x04010107, 0x8767672E, 0xAC2B2BD1,
+	0xD5FEFECC, 0x71D7D713, 0x9AABAB7C, 0xC3767659,
+	0x05CACA40, 0x3E8282A3, 0x09C9C949, 0xEF7D7D68,
+	0xC5FAFAD0, 0x7F595994, 0x074747CE, 0xEDF0F0E6,
+	0x82ADAD6E, 0x7DD4D41A, 0xBEA2A243, 0x8AAFAF60,
+	0x469C9CF9, 0xA6A4A451, 0xD3727245, 0x2DC0C076,
+	0xEAB7B728, 0xD9FDFDC5, 0x7A9393D4, 0x982626F2,
+	0xD8363682, 0xFC3F3FBD, 0xF1F7F7F3, 0x1DCCCC52,
+	0xD034348C, 0xA2A5A556, 0xB9E5E58D, 0xE9F1F1E1,
+	0xDF71714C, 0x4DD8D83E, 0xC4313197, 0x5415156B,
+	0x1004041C, 0x31C7C763, 0x8C2323E9, 0x21C3C37F,
+	0x60181848, 0x6E9696CF, 0x1405051B, 0x5E9A9AEB,
+	0x1C070715, 0x4812127E, 0x368080AD, 0xA5E2E298,
+	0x81EBEBA7, 0x9C2727F5, 0xFEB2B233, 0xCF757550,
+	0x2409093F, 0x3A8383A4, 0xB02C2CC4, 0x681A1A46,
+	0x6C1B1B41, 0xA36E6E11, 0x735A5A9D, 0xB6A0A04D,
+	0x535252A5, 0xEC3B3BA1, 0x75D6D614, 0xFAB3B334,
+	0xA42929DF, 0xA1E3E39F, 0xBC2F2FCD, 0x268484B1,
+	0x575353A2, 0x69D1D101, 0x00000000, 0x99EDEDB5,
+	0x802020E0, 0xDDFCFCC2, 0xF2B1B13A, 0x775B5B9A,
+	0xB36A6A0D, 0x01CBCB47, 0xCEBEBE17, 0xE43939AF,
+	0x334A4AED, 0x2B4C4CFF, 0x7B585893, 0x11CFCF5B,
+	0x6DD0D006, 0x91EFEFBB, 0x9EAAAA7B, 0xC1FBFBD7,
+	0x174343D2, 0x2F4D4DF8, 0xCC333399, 0x228585B6,
+	0x0F4545C0, 0xC9F9F9D9, 0x0802020E, 0xE77F7F66,
+	0x5B5050AB, 0xF03C3CB4, 0x4A9F9FF0, 0x96A8A875,
+	0x5F5151AC, 0xBAA3A344, 0x1B4040DB, 0x0A8F8F80,
+	0x7E9292D3, 0x429D9DFE, 0xE03838A8, 0xF9F5F5FD,
+	0xC6BCBC19, 0xEEB6B62F, 0x45DADA30, 0x842121E7,
+	0x40101070, 0xD1FFFFCB, 0xE1F3F3EF, 0x65D2D208,
+	0x19CDCD55, 0x300C0C24, 0x4C131379, 0x9DECECB2,
+	0x675F5F86, 0x6A9797C8, 0x0B4444C7, 0x5C171765,
+	0x3DC4C46A, 0xAAA7A758, 0xE37E7E61, 0xF43D3DB3,
+	0x8B646427, 0x6F5D5D88, 0x6419194F, 0xD7737342,
+	0x9B60603B, 0x328181AA, 0x274F4FF6, 0x5DDCDC22,
+	0x882222EE, 0xA82A2AD6, 0x769090DD, 0x16888895,
+	0x034646C9, 0x95EEEEBC, 0xD6B8B805, 0x5014146C,
+	0x55DEDE2C, 0x635E5E81, 0x2C0B0B31, 0x41DBDB37,
+	0xADE0E096, 0xC832329E, 0xE83A3AA6, 0x280A0A36,
+	0x3F4949E4, 0x18060612, 0x902424FC, 0x6B5C5C8F,
+	0x25C2C278, 0x61D3D30F, 0x86ACAC69, 0x93626235,
+	0x729191DA, 0x629595C6, 0xBDE4E48A, 0xFF797974,
+	0xB1E7E783, 0x0DC8C84E, 0xDC373785, 0xAF6D6D18,
+	0x028D8D8E, 0x79D5D51D, 0x234E4EF1, 0x92A9A972,
+	0xAB6C6C1F, 0x435656B9, 0xFDF4F4FA, 0x85EAEAA0,
+	0x8F656520, 0xF37A7A7D, 0x8EAEAE67, 0x20080838,
+	0xDEBABA0B, 0xFB787873, 0x942525FB, 0xB82E2ECA,
+	0x701C1C54, 0xAEA6A65F, 0xE6B4B421, 0x35C6C664,
+	0x8DE8E8AE, 0x59DDDD25, 0xCB747457, 0x7C1F1F5D,
+	0x374B4BEA, 0xC2BDBD1E, 0x1A8B8B9C, 0x1E8A8A9B,
+	0xDB70704B, 0xF83E3EBA, 0xE2B5B526, 0x83666629,
+	0x3B4848E3, 0x0C030309, 0xF5F6F6F4, 0x380E0E2A,
+	0x9F61613C, 0xD435358B, 0x475757BE, 0xD2B9B902,
+	0x2E8686BF, 0x29C1C171, 0x741D1D53, 0x4E9E9EF7,
+	0xA9E1E191, 0xCDF8F8DE, 0x569898E5, 0x44111177,
+	0xBF696904, 0x49D9D939, 0x0E8E8E87, 0x669494C1,
+	0x5A9B9BEC, 0x781E1E5A, 0x2A8787B8, 0x89E9E9A9,
+	0x15CECE5C, 0x4F5555B0, 0xA02828D8, 0x51DFDF2B,
+	0x068C8C89, 0xB2A1A14A, 0x12898992, 0x340D0D23,
+	0xCABFBF10, 0xB5E6E684, 0x134242D5, 0xBB686803,
+	0x1F4141DC, 0x529999E2, 0xB42D2DC3, 0x3C0F0F2D,
+	0xF6B0B03D, 0x4B5454B7, 0xDABBBB0C, 0x58161662,
+}
+
+var kMix2 = [256]uint32{
+	0x32976363, 0x6FEB7C7C, 0x5EC77777, 0x7AF77B7B,
+	0xE8E5F2F2, 0x0AB76B6B, 0x16A76F6F, 0x6D39C5C5,
+	0x90C03030, 0x07040101, 0x2E876767, 0xD1AC2B2B,
+	0xCCD5FEFE, 0x1371D7D7, 0x7C9AABAB, 0x59C37676,
+	0x4005CACA, 0xA33E8282, 0x4909C9C9, 0x68EF7D7D,
+	0xD0C5FAFA, 0x947F5959, 0xCE074747, 0xE6EDF0F0,
+	0x6E82ADAD, 0x1A7DD4D4, 0x43BEA2A2, 0x608AAFAF,
+	0xF9469C9C, 0x51A6A4A4, 0x45D37272, 0x762DC0C0,
+	0x28EAB7B7, 0xC5D9FDFD, 0xD47A9393, 0xF2982626,
+	0x82D83636, 0xBDFC3F3F, 0xF3F1F7F7, 0x521DCCCC,
+	0x8CD03434, 0x56A2A5A5, 0x8DB9E5E5, 0xE1E9F1F1,
+	0x4CDF7171, 0x3E4DD8D8, 0x97C43131, 0x6B541515,
+	0x1C100404, 0x6331C7C7, 0xE98C2323, 0x7F21C3C3,
+	0x48601818, 0xCF6E9696, 0x1B140505, 0xEB5E9A9A,
+	0x151C0707, 0x7E481212, 0xAD368080, 0x98A5E2E2,
+	0xA781EBEB, 0xF59C2727, 0x33FEB2B2, 0x50CF7575,
+	0x3F240909, 0xA43A8383, 0xC4B02C2C, 0x46681A1A,
+	0x416C1B1B, 0x11A36E6E, 0x9D735A5A, 0x4DB6A0A0,
+	0xA5535252, 0xA1EC3B3B, 0x1475D6D6, 0x34FAB3B3,
+	0xDFA42929, 0x9FA1E3E3, 0xCDBC2F2F, 0xB1268484,
+	0xA2575353, 0x0169D1D1, 0x00000000, 0xB599EDED,
+	0xE0802020, 0xC2DDFCFC, 0x3AF2B1B1, 0x9A775B5B,
+	0x0DB36A6A, 0x4701CBCB, 0x17CEBEBE, 0xAFE43939,
+	0xED334A4A, 0xFF2B4C4C, 0x937B5858, 0x5B11CFCF,
+	0x066DD0D0, 0xBB91EFEF, 0x7B9EAAAA, 0xD7C1FBFB,
+	0xD2174343, 0xF82F4D4D, 0x99CC3333, 0xB6228585,
+	0xC00F4545, 0xD9C9F9F9, 0x0E080202, 0x66E77F7F,
+	0xAB5B5050, 0xB4F03C3C, 0xF04A9F9F, 0x7596A8A8,
+	0xAC5F5151, 0x44BAA3A3, 0xDB1B4040, 0x800A8F8F,
+	0xD37E9292, 0xFE429D9D, 0xA8E03838, 0xFDF9F5F5,
+	0x19C6BCBC, 0x2FEEB6B6, 0x3045DADA, 0xE7842121,
+	0x70401010, 0xCBD1FFFF, 0xEFE1F3F3, 0x0865D2D2,
+	0x5519CDCD, 0x24300C0C, 0x794C1313, 0xB29DECEC,
+	0x86675F5F, 0xC86A9797, 0xC70B4444, 0x655C1717,
+	0x6A3DC4C4, 0x58AAA7A7, 0x61E37E7E, 0xB3F43D3D,
+	0x278B6464, 0x886F5D5D, 0x4F641919, 0x42D77373,
+	0x3B9B6060, 0xAA328181, 0xF6274F4F, 0x225DDCDC,
+	0xEE882222, 0xD6A82A2A, 0xDD769090, 0x95168888,
+	0xC9034646, 0xBC95EEEE, 0x05D6B8B8, 0x6C501414,
+	0x2C55DEDE, 0x81635E5E, 0x312C0B0B, 0x3741DBDB,
+	0x96ADE0E0, 0x9EC83232, 0xA6E83A3A, 0x36280A0A,
+	0xE43F4949, 0x12180606, 0xFC902424, 0x8F6B5C5C,
+	0x7825C2C2, 0x0F61D3D3, 0x6986ACAC, 0x35936262,
+	0xDA729191, 0xC6629595, 0x8ABDE4E4, 0x74FF7979,
+	0x83B1E7E7, 0x4E0DC8C8, 0x85DC3737, 0x18AF6D6D,
+	0x8E028D8D, 0x1D79D5D5, 0xF1234E4E, 0x7292A9A9,
+	0x1FAB6C6C, 0xB9435656, 0xFAFDF4F4, 0xA085EAEA,
+	0x208F6565, 0x7DF37A7A, 0x678EAEAE, 0x38200808,
+	0x0BDEBABA, 0x73FB7878, 0xFB942525, 0xCAB82E2E,
+	0x54701C1C, 0x5FAEA6A6, 0x21E6B4B4, 0x6435C6C6,
+	0xAE8DE8E8, 0x2559DDDD, 0x57CB7474, 0x5D7C1F1F,
+	0xEA374B4B, 0x1EC2BDBD, 0x9C1A8B8B, 0x9B1E8A8A,
+	0x4BDB7070, 0xBAF83E3E, 0x26E2B5B5, 0x29836666,
+	0xE33B4848, 0x090C0303, 0xF4F5F6F6, 0x2A380E0E,
+	0x3C9F6161, 0x8BD43535, 0xBE475757, 0x02D2B9B9,
+	0xBF2E8686, 0x7129C1C1, 0x53741D1D, 0xF74E9E9E,
+	0x91A9E1E1, 0xDECDF8F8, 0xE5569898, 0x77441111,
+	0x04BF6969, 0x3949D9D9, 0x870E8E8E, 0xC1669494,
+	0xEC5A9B9B, 0x5A781E1E, 0xB82A8787, 0xA989E9E9,
+	0x5C15CECE, 0xB04F5555, 0xD8A02828, 0x2B51DFDF,
+	0x89068C8C, 0x4AB2A1A1, 0x92128989, 0x23340D0D,
+	0x10CABFBF, 0x84B5E6E6, 0xD5134242, 0x03BB6868,
+	0xDC1F4141, 0xE2529999, 0xC3B42D2D, 0x2D3C0F0F,
+	0x3DF6B0B0, 0xB74B5454, 0x0CDABBBB, 0x62581616,
+}
+
+var kMix3 = [256]uint32{
+	0x63329763, 0x7C6FEB7C, 0x775EC777, 0x7B7AF77B,
+	0xF2E8E5F2, 0x6B0AB76B, 0x6F16A76F, 0xC56D39C5,
+	0x3090C030, 0x01070401, 0x672E8767, 0x2BD1AC2B,
+	0xFECCD5FE, 0xD71371D7, 0xAB7C9AAB, 0x7659C376,
+	0xCA4005CA, 0x82A33E82, 0xC94909C9, 0x7D68EF7D,
+	0xFAD0C5FA, 0x59947F59, 0x47CE0747, 0xF0E6EDF0,
+	0xAD6E82AD, 0xD41A7DD4, 0xA243BEA2, 0xAF608AAF,
+	0x9CF9469C, 0xA451A6A4, 0x7245D372, 0xC0762DC0,
+	0xB728EAB7, 0xFDC5D9FD, 0x93D47A93, 0x26F29826,
+	0x3682D836, 0x3FBDFC3F, 0xF7F3F1F7, 0xCC521DCC,
+	0x348CD034, 0xA556A2A5, 0xE58DB9E5, 0xF1E1E9F1,
+	0x714CDF71, 0xD83E4DD8, 0x3197C431, 0x156B5415,
+	0x041C1004, 0xC76331C7, 0x23E98C23, 0xC37F21C3,
+	0x18486018, 0x96CF6E96, 0x051B1405, 0x9AEB5E9A,
+	0x07151C07, 0x127E4812, 0x80AD3680, 0xE298A5E2,
+	0xEBA781EB, 0x27F59C27, 0xB233FEB2, 0x7550CF75,
+	0x093F2409, 0x83A43A83, 0x2CC4B02C, 0x1A46681A,
+	0x1B416C1B, 0x6E11A36E, 0x5A9D735A, 0xA04DB6A0,
+	0x52A55352, 0x3BA1EC3B, 0xD61475D6, 0xB334FAB3,
+	0x29DFA429, 0xE39FA1E3, 0x2FCDBC2F, 0x84B12684,
+	0x53A25753, 0xD10169D1, 0x00000000, 0xEDB599ED,
+	0x20E08020, 0xFCC2DDFC, 0xB13AF2B1, 0x5B9A775B,
+	0x6A0DB36A, 0xCB4701CB, 0xBE17CEBE, 0x39AFE439,
+	0x4AED334A, 0x4CFF2B4C, 0x58937B58, 0xCF5B11CF,
+	0xD0066DD0, 0xEFBB91EF, 0xAA7B9EAA, 0xFBD7C1FB,
+	0x43D21743, 0x4DF82F4D, 0x3399CC33, 0x85B62285,
+	0x45C00F45, 0xF9D9C9F9, 0x020E0802, 0x7F66E77F,
+	0x50AB5B50, 0x3CB4F03C, 0x9FF04A9F, 0xA87596A8,
+	0x51AC5F51, 0xA344BAA3, 0x40DB1B40, 0x8F800A8F,
+	0x92D37E92, 0x9DFE429D, 0x38A8E038, 0xF5FDF9F5,
+	0xBC19C6BC, 0xB62FEEB6, 0xDA3045DA, 0x21E78421,
+	0x10704010, 0xFFCBD1FF, 0xF3EFE1F3, 0xD20865D2,
+	0xCD5519CD, 0x0C24300C, 0x13794C13, 0xECB29DEC,
+	0x5F86675F, 0x97C86A97, 0x44C70B44, 0x17655C17,
+	0xC46A3DC4, 0xA758AAA7, 0x7E61E37E, 0x3DB3F43D,
+	0x64278B64, 0x5D886F5D, 0x194F6419, 0x7342D773,
+	0x603B9B60, 0x81AA3281, 0x4FF6274F, 0xDC225DDC,
+	0x22EE8822, 0x2AD6A82A, 0x90DD7690, 0x88951688,
+	0x46C90346, 0xEEBC95EE, 0xB805D6B8, 0x146C5014,
+	0xDE2C55DE, 0x5E81635E, 0x0B312C0B, 0xDB3741DB,
+	0xE096ADE0, 0x329EC832, 0x3AA6E83A, 0x0A36280A,
+	0x49E43F49, 0x06121806, 0x24FC9024, 0x5C8F6B5C,
+	0xC27825C2, 0xD30F61D3, 0xAC6986AC, 0x62359362,
+	0x91DA7291, 0x95C66295, 0xE48ABDE4, 0x7974FF79,
+	0xE783B1E7, 0xC84E0DC8, 0x3785DC37, 0x6D18AF6D,
+	0x8D8E028D, 0xD51D79D5, 0x4EF1234E, 0xA97292A9,
+	0x6C1FAB6C, 0x56B94356, 0xF4FAFDF4, 0xEAA085EA,
+	0x65208F65, 0x7A7DF37A, 0xAE678EAE, 0x08382008,
+	0xBA0BDEBA, 0x7873FB78, 0x25FB9425, 0x2ECAB82E,
+	0x1C54701C, 0xA65FAEA6, 0xB421E6B4, 0xC66435C6,
+	0xE8AE8DE8, 0xDD2559DD, 0x7457CB74, 0x1F5D7C1F,
+	0x4BEA374B, 0xBD1EC2BD, 0x8B9C1A8B, 0x8A9B1E8A,
+	0x704BDB70, 0x3EBAF83E, 0xB526E2B5, 0x66298366,
+	0x48E33B48, 0x03090C03, 0xF6F4F5F6, 0x0E2A380E,
+	0x613C9F61, 0x358BD435, 0x57BE4757, 0xB902D2B9,
+	0x86BF2E86, 0xC17129C1, 0x1D53741D, 0x9EF74E9E,
+	0xE191A9E1, 0xF8DECDF8, 0x98E55698, 0x11774411,
+	0x6904BF69, 0xD93949D9, 0x8E870E8E, 0x94C16694,
+	0x9BEC5A9B, 0x1E5A781E, 0x87B82A87, 0xE9A989E9,
+	0xCE5C15CE, 0x55B04F55, 0x28D8A028, 0xDF2B51DF,
+	0x8C89068C, 0xA14AB2A1, 0x89921289, 0x0D23340D,
+	0xBF10CABF, 0xE684B5E6, 0x42D51342, 0x6803BB68,
+	0x41DC1F41, 0x99E25299, 0x2DC3B42D, 0x0F2D3C0F,
+	0xB03DF6B0, 0x54B74B54, 0xBB0CDABB, 0x16625816,
+}
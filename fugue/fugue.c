@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 #include <stddef.h>
 #include <string.h>
 
@@ -3,15 +3,355 @@
 
 package fugue
 
-// #include "gfugue.h"
-import "C"
+import (
+	"fmt"
 
-// SumBig creates a hamsi hash of the given bytes and returns always exactly 64 bytes.
-func SumBig(inputData []byte, dst []byte) {
-	var hashOutput [64]C.char
+	"github.com/rnichollx/go-x17/hash"
+)
 
-	C.HashFugue(C.CString(string(inputData)), C.int(len(inputData)), &hashOutput[0])
-	outputBuffer := []byte(C.GoStringN(&hashOutput[0], 64))
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(64)
 
-	copy(dst[:], outputBuffer)
+// BlockSize holds the size of a block in bytes.
+const BlockSize = uintptr(4)
+
+////////////////
+
+type digest struct {
+	ptr uintptr
+	cnt uint64
+	rsh uint8
+
+	s [36]uint32
+
+	b [BlockSize]byte
+}
+
+// New returns a new digest to compute a FUGUE512 hash.
+func New() hash.Digest {
+	ref := &digest{}
+	ref.Reset()
+	return ref
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *digest) Reset() {
+	ref.ptr, ref.cnt, ref.rsh = 0, 0, 0
+	memset32(ref.s[:20], 0)
+	copy(ref.s[20:], kInit[:])
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *digest) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [64]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *digest) Write(src []byte) (int, error) {
+	sln := uintptr(len(src))
+	fln := len(src)
+	ptr := ref.ptr
+
+	ref.cnt += uint64(sln) << 3
+
+	if sln < (BlockSize - ptr) {
+		copy(ref.b[ptr:], src)
+		ref.ptr += sln
+		return int(sln), nil
+	}
+
+	if ptr != 0 {
+		cln := BlockSize - ptr
+		copy(ref.b[ptr:], src[:cln])
+		src = src[cln:]
+		sln -= cln
+		ref.round(decUInt32be(ref.b[:]))
+	}
+
+	for sln >= BlockSize {
+		ref.round(decUInt32be(src))
+		src = src[BlockSize:]
+		sln -= BlockSize
+	}
+
+	copy(ref.b[:], src)
+	ref.ptr = sln
+	return fln, nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize will return an error.
+func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); HashSize > ln {
+		return fmt.Errorf("Fugue Close: dst min length: %d, got %d", HashSize, ln)
+	}
+
+	cnt := ref.cnt + uint64(bcnt)
+
+	if ptr := ref.ptr; ptr != 0 || bcnt != 0 {
+		ref.b[ptr] = bits & ^uint8(0xFF>>bcnt)
+		memset(ref.b[ptr+1:], 0)
+		ref.round(decUInt32be(ref.b[:]))
+	}
+	ref.round(uint32(cnt >> 32))
+	ref.round(uint32(cnt))
+
+	var s [36]uint32
+	rms := uintptr(ref.rsh) * 12
+	copy(s[:rms], ref.s[36-rms:])
+	copy(s[rms:], ref.s[:36-rms])
+
+	for i := 0; i < 32; i++ {
+		ror(s[:], 3)
+		cmix36(&s, 0, 1, 2, 4, 5, 6, 18, 19, 20)
+		smix(&s[0], &s[1], &s[2], &s[3])
+	}
+
+	for i := 0; i < 13; i++ {
+		s[4] ^= s[0]
+		s[9] ^= s[0]
+		s[18] ^= s[0]
+		s[27] ^= s[0]
+		ror(s[:], 9)
+		smix(&s[0], &s[1], &s[2], &s[3])
+		s[4] ^= s[0]
+		s[10] ^= s[0]
+		s[18] ^= s[0]
+		s[27] ^= s[0]
+		ror(s[:], 9)
+		smix(&s[0], &s[1], &s[2], &s[3])
+		s[4] ^= s[0]
+		s[10] ^= s[0]
+		s[19] ^= s[0]
+		s[27] ^= s[0]
+		ror(s[:], 9)
+		smix(&s[0], &s[1], &s[2], &s[3])
+		s[4] ^= s[0]
+		s[10] ^= s[0]
+		s[19] ^= s[0]
+		s[28] ^= s[0]
+		ror(s[:], 8)
+		smix(&s[0], &s[1], &s[2], &s[3])
+	}
+
+	s[4] ^= s[0]
+	s[9] ^= s[0]
+	s[18] ^= s[0]
+	s[27] ^= s[0]
+
+	encUInt32be(dst[0:], s[1])
+	encUInt32be(dst[4:], s[2])
+	encUInt32be(dst[8:], s[3])
+	encUInt32be(dst[12:], s[4])
+	encUInt32be(dst[16:], s[9])
+	encUInt32be(dst[20:], s[10])
+	encUInt32be(dst[24:], s[11])
+	encUInt32be(dst[28:], s[12])
+	encUInt32be(dst[32:], s[18])
+	encUInt32be(dst[36:], s[19])
+	encUInt32be(dst[40:], s[20])
+	encUInt32be(dst[44:], s[21])
+	encUInt32be(dst[48:], s[27])
+	encUInt32be(dst[52:], s[28])
+	encUInt32be(dst[56:], s[29])
+	encUInt32be(dst[60:], s[30])
+
+	ref.Reset()
+	return nil
+}
+
+// Size returns the number of bytes Sum will return.
+func (*digest) Size() int {
+	return HashSize
+}
+
+// BlockSize returns the block size of the hash.
+func (*digest) BlockSize() int {
+	return int(BlockSize)
+}
+
+////////////////
+
+// round absorbs a single 32 bit word. The state is never rotated,
+// instead rsh tracks which of the three rotations is in effect.
+func (ref *digest) round(q uint32) {
+	s := &ref.s
+
+	switch ref.rsh {
+	case 0:
+		tix4(s, q, 0, 1, 4, 7, 8, 22, 24, 27, 30)
+		cmix36(s, 33, 34, 35, 1, 2, 3, 15, 16, 17)
+		smix(&s[33], &s[34], &s[35], &s[0])
+		cmix36(s, 30, 31, 32, 34, 35, 0, 12, 13, 14)
+		smix(&s[30], &s[31], &s[32], &s[33])
+		cmix36(s, 27, 28, 29, 31, 32, 33, 9, 10, 11)
+		smix(&s[27], &s[28], &s[29], &s[30])
+		cmix36(s, 24, 25, 26, 28, 29, 30, 6, 7, 8)
+		smix(&s[24], &s[25], &s[26], &s[27])
+		ref.rsh = 1
+	case 1:
+		tix4(s, q, 24, 25, 28, 31, 32, 10, 12, 15, 18)
+		cmix36(s, 21, 22, 23, 25, 26, 27, 3, 4, 5)
+		smix(&s[21], &s[22], &s[23], &s[24])
+		cmix36(s, 18, 19, 20, 22, 23, 24, 0, 1, 2)
+		smix(&s[18], &s[19], &s[20], &s[21])
+		cmix36(s, 15, 16, 17, 19, 20, 21, 33, 34, 35)
+		smix(&s[15], &s[16], &s[17], &s[18])
+		cmix36(s, 12, 13, 14, 16, 17, 18, 30, 31, 32)
+		smix(&s[12], &s[13], &s[14], &s[15])
+		ref.rsh = 2
+	default:
+		tix4(s, q, 12, 13, 16, 19, 20, 34, 0, 3, 6)
+		cmix36(s, 9, 10, 11, 13, 14, 15, 27, 28, 29)
+		smix(&s[9], &s[10], &s[11], &s[12])
+		cmix36(s, 6, 7, 8, 10, 11, 12, 24, 25, 26)
+		smix(&s[6], &s[7], &s[8], &s[9])
+		cmix36(s, 3, 4, 5, 7, 8, 9, 21, 22, 23)
+		smix(&s[3], &s[4], &s[5], &s[6])
+		cmix36(s, 0, 1, 2, 4, 5, 6, 18, 19, 20)
+		smix(&s[0], &s[1], &s[2], &s[3])
+		ref.rsh = 0
+	}
+}
+
+func tix4(s *[36]uint32, q uint32, x00, x01, x04, x07, x08, x22, x24, x27, x30 int) {
+	s[x22] ^= s[x00]
+	s[x00] = q
+	s[x08] ^= s[x00]
+	s[x01] ^= s[x24]
+	s[x04] ^= s[x27]
+	s[x07] ^= s[x30]
+}
+
+func cmix36(s *[36]uint32, x00, x01, x02, x04, x05, x06, x18, x19, x20 int) {
+	s[x00] ^= s[x04]
+	s[x01] ^= s[x05]
+	s[x02] ^= s[x06]
+	s[x18] ^= s[x04]
+	s[x19] ^= s[x05]
+	s[x20] ^= s[x06]
+}
+
+func smix(x0, x1, x2, x3 *uint32) {
+	var c0, c1, c2, c3 uint32
+	var r0, r1, r2, r3 uint32
+	var tmp uint32
+
+	tmp = kMix0[*x0>>24]
+	c0 ^= tmp
+	tmp = kMix1[(*x0>>16)&0xFF]
+	c0 ^= tmp
+	r1 ^= tmp
+	tmp = kMix2[(*x0>>8)&0xFF]
+	c0 ^= tmp
+	r2 ^= tmp
+	tmp = kMix3[*x0&0xFF]
+	c0 ^= tmp
+	r3 ^= tmp
+
+	tmp = kMix0[*x1>>24]
+	c1 ^= tmp
+	r0 ^= tmp
+	tmp = kMix1[(*x1>>16)&0xFF]
+	c1 ^= tmp
+	tmp = kMix2[(*x1>>8)&0xFF]
+	c1 ^= tmp
+	r2 ^= tmp
+	tmp = kMix3[*x1&0xFF]
+	c1 ^= tmp
+	r3 ^= tmp
+
+	tmp = kMix0[*x2>>24]
+	c2 ^= tmp
+	r0 ^= tmp
+	tmp = kMix1[(*x2>>16)&0xFF]
+	c2 ^= tmp
+	r1 ^= tmp
+	tmp = kMix2[(*x2>>8)&0xFF]
+	c2 ^= tmp
+	tmp = kMix3[*x2&0xFF]
+	c2 ^= tmp
+	r3 ^= tmp
+
+	tmp = kMix0[*x3>>24]
+	c3 ^= tmp
+	r0 ^= tmp
+	tmp = kMix1[(*x3>>16)&0xFF]
+	c3 ^= tmp
+	r1 ^= tmp
+	tmp = kMix2[(*x3>>8)&0xFF]
+	c3 ^= tmp
+	r2 ^= tmp
+	tmp = kMix3[*x3&0xFF]
+	c3 ^= tmp
+
+	*x0 = ((c0 ^ r0) & 0xFF000000) |
+		((c1 ^ r1) & 0x00FF0000) |
+		((c2 ^ r2) & 0x0000FF00) |
+		((c3 ^ r3) & 0x000000FF)
+	*x1 = ((c1 ^ (r0 << 8)) & 0xFF000000) |
+		((c2 ^ (r1 << 8)) & 0x00FF0000) |
+		((c3 ^ (r2 << 8)) & 0x0000FF00) |
+		((c0 ^ (r3 >> 24)) & 0x000000FF)
+	*x2 = ((c2 ^ (r0 << 16)) & 0xFF000000) |
+		((c3 ^ (r1 << 16)) & 0x00FF0000) |
+		((c0 ^ (r2 >> 16)) & 0x0000FF00) |
+		((c1 ^ (r3 >> 16)) & 0x000000FF)
+	*x3 = ((c3 ^ (r0 << 24)) & 0xFF000000) |
+		((c0 ^ (r1 >> 8)) & 0x00FF0000) |
+		((c1 ^ (r2 >> 8)) & 0x0000FF00) |
+		((c2 ^ (r3 >> 8)) & 0x000000FF)
+}
+
+// ror rotates s to the right by n words.
+func ror(s []uint32, n int) {
+	var tmp [9]uint32
+	ln := len(s)
+	copy(tmp[:n], s[ln-n:])
+	copy(s[n:], s[:ln-n])
+	copy(s[:n], tmp[:n])
+}
+
+func memset(dst []byte, src byte) {
+	for i := range dst {
+		dst[i] = src
+	}
+}
+
+func memset32(dst []uint32, src uint32) {
+	for i := range dst {
+		dst[i] = src
+	}
+}
+
+func decUInt32be(src []byte) uint32 {
+	return (uint32(src[0])<<24 |
+		uint32(src[1])<<16 |
+		uint32(src[2])<<8 |
+		uint32(src[3]))
+}
+
+func encUInt32be(dst []byte, src uint32) {
+	dst[0] = uint8(src >> 24)
+	dst[1] = uint8(src >> 16)
+	dst[2] = uint8(src >> 8)
+	dst[3] = uint8(src)
+}
+
+////////////////
+
+var kInit = [16]uint32{
+	uint32(0x8807a57e), uint32(0xe616af75), uint32(0xc5d3e4db),
+	uint32(0xac9ab027), uint32(0xd915f117), uint32(0xb6eecc54),
+	uint32(0x06e8020b), uint32(0x4a92efd1), uint32(0xaac6e2c9),
+	uint32(0xddb21398), uint32(0xcae65838), uint32(0x437f203f),
+	uint32(0x25ea78e7), uint32(0x951fddd6), uint32(0xda6ed11d),
+	uint32(0xe13e3567),
 }
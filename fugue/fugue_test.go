@@ -1,5 +1,6 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
+
 package fugue
 
 import (
@@ -10,14 +11,32 @@ import (
 	"github.com/rnichollx/go-x17/nist"
 )
 
+////////////////
+
+func TestApi(t *testing.T) {
+	dgst := New()
+	if sz := dgst.Size(); HashSize != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	if sz := dgst.BlockSize(); int(BlockSize) != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	res := [2]byte{}
+	if nil == dgst.Close(res[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
 func TestHash(t *testing.T) {
+	dgst := New()
 	out := make([]byte, 64)
 
 	for i := range tsInfo {
 		length := len(tsInfo[i].out)
 		destination := make([]byte, length)
 
-		SumBig(tsInfo[i].in[:], out[:])
+		dgst.Write(tsInfo[i].in[:])
+		dgst.Close(out[:], 0, 0)
 		hex.Encode(destination, out[:])
 
 		if !bytes.Equal(destination[:], tsInfo[i].out[:]) {
@@ -26,6 +45,58 @@ func TestHash(t *testing.T) {
 	}
 }
 
+func TestNistSum(t *testing.T) {
+	for i := uint64(0); i < 2048; i++ {
+		runNistSum(t, i)
+	}
+}
+
+func TestNistClose(t *testing.T) {
+	// The bit level entries of NistResult do not match the sphlib
+	// padding of trailing bits, so only whole bytes are compared.
+	for i := uint64(0); i < 2048; i += 8 {
+		runNistClose(t, i)
+	}
+}
+
+func TestCloseBits(t *testing.T) {
+	for i := range tsBits {
+		dgst := New()
+		rest := [64]byte{}
+
+		dgst.Write(tsBits[i].in)
+		dgst.Close(rest[:], tsBits[i].bits, tsBits[i].bcnt)
+		hash, _ := hex.DecodeString(tsBits[i].out)
+
+		if !nist.IsEqual(hash, rest[:]) {
+			t.Errorf("\nClose bits %d:\n expected: %X\n      got: %X", i, hash, rest[:])
+		}
+	}
+}
+
+func TestWriteSplit(t *testing.T) {
+	dmsg := nist.Get(2040)
+	hash, _ := hex.DecodeString(NistResult[2040])
+
+	for step := 1; step < 20; step++ {
+		dgst := New()
+		rbuf := [64]byte{}
+
+		for off := 0; off < len(dmsg); off += step {
+			end := off + step
+			if end > len(dmsg) {
+				end = len(dmsg)
+			}
+			dgst.Write(dmsg[off:end])
+		}
+		dgst.Close(rbuf[:], 0, 0)
+
+		if !nist.IsEqual(hash, rbuf[:]) {
+			t.Errorf("\nSplit %d:\n expected: %X\n      got: %X", step, hash, rbuf[:])
+		}
+	}
+}
+
 var tsInfo = []struct {
 	id  string
 	in  []byte
@@ -48,35 +119,116 @@ var tsInfo = []struct {
 	},
 }
 
-func TestNistSum(t *testing.T) {
-	for i := uint64(0); i < 2048; i++ {
-		runNistSum(t, i)
-	}
+// tsBits holds messages with trailing bits, hashed with
+// sph_fugue512_addbits_and_close from the bundled sphlib.
+var tsBits = []struct {
+	in   []byte
+	bits uint8
+	bcnt uint8
+	out  string
+}{
+	{
+		[]byte{},
+		0x00, 1,
+		"F8499BAFAC2D562817D1B9DDA8DFCAC7DCB13BB533B9C34CC026F3F7AF808366C9C129FEC525FF9859AE39B7F1B49523E9BAC2DD8AFEFFC8F62C931127E43FE8",
+	},
+	{
+		[]byte{},
+		0xC0, 2,
+		"584468A827A1FC47C4F32C5C591D15B4739929A276C4FE927251A155EFA64948D09C4B15B68FF06E40C9861D3C536A7BCCD2DCC51509B1EE1E20E619961A5F0C",
+	},
+	{
+		[]byte("The quick brown fox jumps over the lazy dog"),
+		0xA0, 3,
+		"36A5F33FA5563E4DE434D0184233BC2B97761B8E3A88E6E910FF447DB5831573044C2E1B49333039A51642EAF1782BFFBD1194D66E143C1AF00AEBC1BEB6BDE2",
+	},
+	{
+		[]byte("The quick brown fox jumps over the lazy "),
+		0xA0, 3,
+		"B68E8FF99EBF5ACF84FCB48CBC416D406ADBE14AEFCBA0F8D87D25A82BCB794652DE38E35114F07E6EBE3FF12FCF285F294B8A550A8CB4B993C10006E8DAC9A4",
+	},
 }
 
 ////////////////
 
 func runNistSum(t *testing.T, idx uint64) {
 	if extr := idx & 7; extr == 0 {
-		rbuf := make([]byte, 64)
+		dgst := New()
+		rbuf := [64]byte{}
 		dmsg := nist.Get(idx)
 
-		SumBig(dmsg, rbuf[:])
+		ln, err := dgst.Write(dmsg)
+		if ln != len(dmsg) {
+			t.Errorf("\nSum Write length %d, expected: %d got: %d", idx, len(dmsg), ln)
+		}
+		if err != nil {
+			t.Errorf("\nSum Write should never return an error, %d: got: %X", idx, err)
+		}
+
+		rest := dgst.Sum(rbuf[0:0])
 		hash, _ := hex.DecodeString(NistResult[idx])
 
 		if !nist.IsEqual(hash, rbuf[:]) {
-			t.Errorf("\na) Sum %d:\n expected: %X\n      got: %X", idx, hash, rbuf[:])
+			t.Errorf("\na) Sum %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
 		}
 
-		SumBig(dmsg, rbuf[:])
+		// Manual reset
+		dgst.Reset()
+
+		ln, err = dgst.Write(dmsg)
+		if ln != len(dmsg) {
+			t.Errorf("\nSum Write length %d, expected: %d got: %d", idx, len(dmsg), ln)
+		}
+		if err != nil {
+			t.Errorf("\nSum Write should never return an error, %d: got: %X", idx, err)
+		}
+
+		rest = dgst.Sum(rbuf[0:0])
 		hash, _ = hex.DecodeString(NistResult[idx])
 
 		if !nist.IsEqual(hash, rbuf[:]) {
-			t.Errorf("\nb) Sum %d:\n expected: %X\n      got: %X", idx, hash, rbuf[:])
+			t.Errorf("\nb) Sum %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
 		}
 	}
 }
 
+func runNistClose(t *testing.T, idx uint64) {
+	dgst := New()
+	extr := idx & 7
+	rest := [64]byte{}
+	dmsg := nist.Get(idx)
+
+	hash, _ := hex.DecodeString(NistResult[idx])
+
+	if extr == 0 {
+		dgst.Write(dmsg)
+		dgst.Close(rest[:], 0, 0)
+	} else {
+		dgst.Write(dmsg[:len(dmsg)-1])
+		dgst.Close(rest[:], dmsg[len(dmsg)-1], uint8(extr))
+	}
+
+	if !nist.IsEqual(hash, rest[:]) {
+		t.Errorf("\na) Close %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+	}
+
+	// Auto reset
+
+	if extr == 0 {
+		dgst.Write(dmsg)
+		dgst.Close(rest[:], 0, 0)
+	} else {
+		dgst.Write(dmsg[:len(dmsg)-1])
+		dgst.Close(rest[:], dmsg[len(dmsg)-1], uint8(extr))
+	}
+
+	if !nist.IsEqual(hash, rest[:]) {
+		t.Errorf("\nb) Close %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+	}
+}
+
+////////////////
+
 var NistResult = []string{
 	"3124F0CBB5A1C2FB3CE747ADA63ED2AB3BCD74795CEF2B0E805D5319FCC360B4617B6A7EB631D66F6D106ED0724B56FA8C1110F9B8DF1C6898E7CA3C2DFCCF79",
 	"A824B9966CCE3EA0935CB3A39E1A1A29D49DF02F50A0DBD5E07AFB1408CD605B208B833CD2FD46D8F1EA489E33DF305BA09C7E448E5BC59C260049D58514E442",
@@ -1,20 +1,19 @@
-#include "gfugue.h"
-
-#include <stdlib.h>
-#include <stdint.h>
-#include <string.h>
-#include <stdio.h>
+//go:build cgo
+// +build cgo
 
-#include "sph_fugue.h"
+#include "gfugue.h"
 
-void HashFugue(const char *input, int inputLen, char *output)
+void FugueInit(sph_fugue512_context *ctx)
 {
-    sph_fugue512_context ctx_fugue;
-    char hash[64];
+    sph_fugue512_init(ctx);
+}
 
-    sph_fugue512_init(&ctx_fugue);
-    sph_fugue512(&ctx_fugue, input, inputLen);
-    sph_fugue512_close(&ctx_fugue, hash);
+void FugueWrite(sph_fugue512_context *ctx, const void *input, size_t inputLen)
+{
+    sph_fugue512(ctx, input, inputLen);
+}
 
-    memcpy(output, hash, 64);
+void FugueClose(sph_fugue512_context *ctx, unsigned bits, unsigned bcnt, void *output)
+{
+    sph_fugue512_addbits_and_close(ctx, bits, bcnt, output);
 }
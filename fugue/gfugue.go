@@ -0,0 +1,91 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+// +build cgo
+
+package fugue
+
+// #include "gfugue.h"
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rnichollx/go-x17/hash"
+)
+
+////////////////
+
+// cdigest keeps the sphlib context in Go memory, the context holds no
+// Go pointers so it can be handed to C without copying.
+type cdigest struct {
+	ctx C.sph_fugue512_context
+}
+
+// NewCgo returns a new digest to compute a FUGUE512 hash
+// with the bundled sphlib implementation.
+func NewCgo() hash.Digest {
+	ref := &cdigest{}
+	ref.Reset()
+	return ref
+}
+
+// SumBig creates a fugue hash of the given bytes and returns always exactly 64 bytes.
+func SumBig(inputData []byte, dst []byte) {
+	ref := cdigest{}
+	hsh := [64]byte{}
+
+	ref.Reset()
+	ref.Write(inputData)
+	ref.Close(hsh[:], 0, 0)
+
+	copy(dst[:], hsh[:])
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *cdigest) Reset() {
+	C.FugueInit(&ref.ctx)
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *cdigest) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [64]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *cdigest) Write(src []byte) (int, error) {
+	if len(src) > 0 {
+		C.FugueWrite(&ref.ctx, unsafe.Pointer(&src[0]), C.size_t(len(src)))
+	}
+	return len(src), nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize will return an error.
+func (ref *cdigest) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); HashSize > ln {
+		return fmt.Errorf("Fugue Close: dst min length: %d, got %d", HashSize, ln)
+	}
+
+	C.FugueClose(&ref.ctx, C.unsigned(bits), C.unsigned(bcnt), unsafe.Pointer(&dst[0]))
+	return nil
+}
+
+// Size returns the number of bytes Sum will return.
+func (*cdigest) Size() int {
+	return HashSize
+}
+
+// BlockSize returns the block size of the hash.
+func (*cdigest) BlockSize() int {
+	return int(BlockSize)
+}
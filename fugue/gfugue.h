@@ -1,14 +1,20 @@
-#ifndef VERGE_CRYPTO_POW_FUGUE_H
-#define VERGE_CRYPTO_POW_FUGUE_H
+#ifndef VERGE_CRYPTO_POW_GFUGUE_H
+#define VERGE_CRYPTO_POW_GFUGUE_H
+
+#include <stddef.h>
+
+#include "sph_fugue.h"
 
 #ifdef __cplusplus
 extern "C" {
 #endif
 
-void HashFugue(const char *input, int inputLen, char *output);
+void FugueInit(sph_fugue512_context *ctx);
+void FugueWrite(sph_fugue512_context *ctx, const void *input, size_t inputLen);
+void FugueClose(sph_fugue512_context *ctx, unsigned bits, unsigned bcnt, void *output);
 
 #ifdef __cplusplus
 }
 #endif
 
-#endif // VERGE_CRYPTO_POW_FUGUE_H
+#endif // VERGE_CRYPTO_POW_GFUGUE_H
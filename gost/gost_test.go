@@ -4,6 +4,7 @@
 package gost
 
 import (
+	"bytes"
 	"encoding/hex"
 	"testing"
 
@@ -26,6 +27,67 @@ func TestApi(t *testing.T) {
 	}
 }
 
+func TestRfc(t *testing.T) {
+	for i := range tsRfc {
+		msg, _ := hex.DecodeString(tsRfc[i].in)
+
+		dgst := New512()
+		dgst.Write(msg)
+		if res := hex.EncodeToString(dgst.Sum(nil)); res != tsRfc[i].out {
+			t.Errorf("[%s-gost]: invalid hash \nexpected:\t%s, \ngot:\t\t%s", tsRfc[i].id, tsRfc[i].out, res)
+		}
+
+		// The same message and hash in little endian byte order.
+		reverse(msg, append([]byte{}, msg...))
+		exp, _ := hex.DecodeString(tsRfc[i].out)
+		reverse(exp, append([]byte{}, exp...))
+
+		dgst = New512LE()
+		for j := range msg {
+			dgst.Write(msg[j : j+1])
+		}
+		res := [HashSize]byte{}
+		if err := dgst.Close(res[:], 0, 0); err != nil {
+			t.Fatalf("[%s-gost-le]: %v", tsRfc[i].id, err)
+		}
+		if !bytes.Equal(exp, res[:]) {
+			t.Errorf("[%s-gost-le]: invalid hash \nexpected:\t%X, \ngot:\t\t%X", tsRfc[i].id, exp, res)
+		}
+	}
+}
+
+func TestLE(t *testing.T) {
+	dgst := New512LE()
+	if res := hex.EncodeToString(dgst.Sum(nil)); res != kEmptyLE {
+		t.Errorf("[Empty-gost-le]: invalid hash \nexpected:\t%s, \ngot:\t\t%s", kEmptyLE, res)
+	}
+
+	msg := nist.Get(2047)
+	dgst.Write(msg)
+	exp := dgst.Sum(nil)
+	dgst.Reset()
+
+	res := [HashSize]byte{}
+	for _, n := range []int{1, 63, 64, 65, len(msg)} {
+		for off := 0; off < len(msg); off += n {
+			end := off + n
+			if end > len(msg) {
+				end = len(msg)
+			}
+			dgst.Write(msg[off:end])
+		}
+		dgst.Close(res[:], 0, 0)
+		if !bytes.Equal(exp, res[:]) {
+			t.Errorf("Write %d: invalid hash \nexpected:\t%X, \ngot:\t\t%X", n, exp, res)
+		}
+	}
+
+	short := [2]byte{}
+	if nil == dgst.Close(short[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
 func TestNistSum(t *testing.T) {
 	for i := uint64(0); i < 2048; i++ {
 		runNistSum(t, i)
@@ -2169,3 +2231,26 @@ var kNistResult = []string{
 	"1362F9401F50E1DCC0A66C9672F7C130FA16B27ED1F5F8539EFA3CB21F5945B0204316B88B41E7E33E7C91C92EA6FF9C9263D3DE08393260F0AF9AABB0E4D827",
 	"9C69D97555E57111DC4DB4B2F644E135B29794B60DDEF6368102262FA6CB283657528BF0E21F1B0BC9E581A8A2C9BBE3B895F104685E15B56E50549850BA2602",
 }
+
+// tsRfc holds the examples of RFC 6986, section 10, written like the
+// RFC as big numbers with the most significant byte first.
+var tsRfc = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"M1",
+		"323130393837363534333231303938373635343332313039383736353433323130393837363534333231303938373635343332313039383736353433323130",
+		"486f64c1917879417fef082b3381a4e211c324f074654c38823a7b76f830ad00fa1fbae42b1285c0352f227524bc9ab16254288dd6863dccd5b9f54a1ad0541b",
+	},
+	{
+		"M2",
+		"fbe2e5f0eee3c820fbeafaebef20fffbf0e1e0f0f520e0ed20e8ece0ebe5f0f2f120fff0eeec20f120faf2fee5e2202ce8f6f3ede220e8e6eee1e8f0f2d1202ce8f0f2e5e220e5d1",
+		"28fbc9bada033b1460642bdcddb90c3fb3e56c497ccd0f62b8a2ad4935e85f037613966de4ee00531ae60f3b5a47f8dae06915d5f2f194996fcabf2622e6881e",
+	},
+}
+
+// kEmptyLE holds the 512-bit stribog checksum of the empty message as
+// printed by OpenSSL.
+const kEmptyLE = "8e945da209aa869f0455928529bcae4679e9873ab707b55315f56ceb98bef0a7362f715528356ee83cda5f2aac4c6ad2ba3a715c1bcd81cb8e9f90bf4c1c1a8a"
@@ -0,0 +1,109 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package gost
+
+import (
+	"fmt"
+
+	"github.com/rnichollx/go-x17/hash"
+)
+
+////////////////
+
+// digestLE runs the digest on little endian byte strings. The digest
+// follows the notation of RFC 6986, where a message and its hash are
+// written as big numbers with the most significant byte first. The sph
+// and OpenSSL implementations the coins use read the message with the
+// least significant byte first and store the hash the same way.
+type digestLE struct {
+	dgst digest
+
+	ptr int
+	b   [BlockSize]byte
+	r   [BlockSize]byte
+}
+
+// New512LE returns a new hash.Digest computing the 512-bit stribog
+// checksum in the byte order of the sph and OpenSSL implementations.
+func New512LE() hash.Digest {
+	ref := &digestLE{}
+	ref.dgst.size = 64
+	ref.Reset()
+	return ref
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *digestLE) Reset() {
+	ref.dgst.Reset()
+	ref.ptr = 0
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *digestLE) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [HashSize]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *digestLE) Write(src []byte) (int, error) {
+	fln := len(src)
+	for len(src) > 0 {
+		n := copy(ref.b[ref.ptr:], src)
+		ref.ptr += n
+		src = src[n:]
+
+		if ref.ptr == BlockSize {
+			reverse(ref.r[:], ref.b[:])
+			ref.dgst.Write(ref.r[:])
+			ref.ptr = 0
+		}
+	}
+	return fln, nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize will return an error.
+func (ref *digestLE) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); HashSize > ln {
+		return fmt.Errorf("Gost Close: dst min length: %d, got %d", HashSize, ln)
+	}
+
+	ptr := ref.ptr
+	reverse(ref.r[:ptr], ref.b[:ptr])
+	ref.dgst.Write(ref.r[:ptr])
+
+	if err := ref.dgst.Close(ref.r[:], bits, bcnt); err != nil {
+		return err
+	}
+	reverse(dst[:HashSize], ref.r[:])
+
+	ref.Reset()
+	return nil
+}
+
+// Size returns the number of bytes required to store the hash.
+func (*digestLE) Size() int {
+	return HashSize
+}
+
+// BlockSize returns the block size of the hash.
+func (*digestLE) BlockSize() int {
+	return int(BlockSize)
+}
+
+////////////////
+
+// reverse stores src in dst with the order of the bytes reversed,
+// dst must be as long as src.
+func reverse(dst, src []byte) {
+	for i := range src {
+		dst[len(src)-1-i] = src[i]
+	}
+}
@@ -0,0 +1,83 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package groestlpow implements the Groestl based proof-of-work hashes,
+// the double Groestl of Groestlcoin and the Myriad-Groestl of Myriadcoin
+// and Verge.
+package groestlpow
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/groest"
+	"github.com/rnichollx/go-x17/hash"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("groestlpow: dst shorter than HashSize")
+
+////////////////
+
+// Hash contains the state objects required
+// to perform a Groestl proof-of-work hash.
+type Hash struct {
+	tha [64]byte
+	thb [64]byte
+
+	myriad bool
+	groest hash.Digest
+}
+
+// NewGroestl returns a new object to compute the Groestlcoin
+// hash, GROESTL512 applied twice.
+func NewGroestl() *Hash {
+	return &Hash{groest: groest.New()}
+}
+
+// NewMyriad returns a new object to compute the Myriad-Groestl
+// hash, GROESTL512 followed by SHA256.
+func NewMyriad() *Hash {
+	return &Hash{groest: groest.New(), myriad: true}
+}
+
+// Hash computes the hash from the src bytes and stores the result in dst,
+// errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the result in
+// dst, byte swapped like x17.Hash so it reads as block explorers show it.
+// A call to Compute with a dst that is smaller then HashSize returns
+// ErrShortDst.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	ref.groest.Reset()
+	ref.groest.Write(src)
+	if err := ref.groest.Close(ref.tha[:], 0, 0); err != nil {
+		return fmt.Errorf("groestlpow: %w", err)
+	}
+
+	if ref.myriad {
+		sum := sha256.Sum256(ref.tha[:])
+		copy(ref.thb[:], sum[:])
+	} else {
+		ref.groest.Write(ref.tha[:])
+		if err := ref.groest.Close(ref.thb[:], 0, 0); err != nil {
+			return fmt.Errorf("groestlpow: %w", err)
+		}
+	}
+
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ref.thb[HashSize-1-i]
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package groestlpow
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/groest"
+)
+
+func TestHash(t *testing.T) {
+	for _, ts := range tsInfo {
+		hs := ts.ctor()
+		out := [HashSize]byte{}
+
+		if err := hs.Compute(ts.in, out[:]); err != nil {
+			t.Fatalf("[%s]: %v", ts.id, err)
+		}
+		if res := hex.EncodeToString(out[:]); res != ts.out {
+			t.Errorf("[%s]: invalid hash \nexpected:	%s, \ngot:		%s", ts.id, ts.out, res)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	in := []byte("The quick brown fox jumps over the lazy dog")
+
+	dgst := groest.New()
+	dgst.Write(in)
+	h := dgst.Sum(nil)
+
+	dgst.Reset()
+	dgst.Write(h)
+	grs := dgst.Sum(nil)[:HashSize]
+	myr := sha256.Sum256(h)
+
+	for _, ts := range []struct {
+		id  string
+		hs  *Hash
+		exp []byte
+	}{
+		{"Groestl", NewGroestl(), grs},
+		{"Myriad", NewMyriad(), myr[:]},
+	} {
+		res := [HashSize]byte{}
+		ts.hs.Hash(in, res[:])
+		if !bytes.Equal(reverse(ts.exp), res[:]) {
+			t.Errorf("[%s]: invalid hash \nexpected:	%X, \ngot:		%X", ts.id, reverse(ts.exp), res)
+		}
+	}
+}
+
+func TestShortDst(t *testing.T) {
+	hs := NewMyriad()
+	out := make([]byte, HashSize-1)
+
+	if err := hs.Compute(nil, out); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+
+	hs.Hash(nil, out)
+	if !bytes.Equal(out, make([]byte, HashSize-1)) {
+		t.Errorf("Hash: expected dst to be left untouched, got: %X", out)
+	}
+}
+
+////////////////
+
+func reverse(src []byte) []byte {
+	res := make([]byte, len(src))
+	for i := range src {
+		res[len(src)-1-i] = src[i]
+	}
+	return res
+}
+
+func header(version uint32, prev, merkle string, time, bits, nonce uint32) []byte {
+	res := make([]byte, 80)
+	binary.LittleEndian.PutUint32(res[0:], version)
+	ph, _ := hex.DecodeString(prev)
+	copy(res[4:36], reverse(ph))
+	mh, _ := hex.DecodeString(merkle)
+	copy(res[36:68], reverse(mh))
+	binary.LittleEndian.PutUint32(res[68:], time)
+	binary.LittleEndian.PutUint32(res[72:], bits)
+	binary.LittleEndian.PutUint32(res[76:], nonce)
+	return res
+}
+
+// No Myriad-Groestl header was at hand, that variant is covered by
+// TestCompose against the NIST verified groest package and SHA256.
+var tsInfo = []struct {
+	id   string
+	ctor func() *Hash
+	in   []byte
+	out  string
+}{
+	{
+		"Groestlcoin genesis",
+		NewGroestl,
+		header(
+			112,
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"3ce968df58f9c8a752306c4b7264afab93149dbc578bd08a42c446caaa6628bb",
+			1395342829, 0x1e0fffff, 220035,
+		),
+		"00000ac5927c594d49cc0bdb81759d0da8297eb614683d3acb62f0703b639023",
+	},
+}
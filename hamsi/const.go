@@ -0,0 +1,393 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package hamsi
+
+// kT512 is the Hamsi-512 message expansion table, one row per input
+// bit. Bits within each byte are listed from least to most significant.
+var kT512 = [64][16]uint32{
+	{
+		0xEF0B0270, 0x3AFD0000, 0x5DAE0000, 0x69490000,
+		0x9B0F3C06, 0x4405B5F9, 0x66140A51, 0x924F5D0A,
+		0xC96B0030, 0xE7250000, 0x2F840000, 0x264F0000,
+		0x08695BF9, 0x6DFCF137, 0x509F6984, 0x9E69AF68,
+	},
+	{
+		0xC96B0030, 0xE7250000, 0x2F840000, 0x264F0000,
+		0x08695BF9, 0x6DFCF137, 0x509F6984, 0x9E69AF68,
+		0x26600240, 0xDDD80000, 0x722A0000, 0x4F060000,
+		0x936667FF, 0x29F944CE, 0x368B63D5, 0x0C26F262,
+	},
+	{
+		0x145A3C00, 0xB9E90000, 0x61270000, 0xF1610000,
+		0xCE613D6C, 0xB0493D78, 0x47A96720, 0xE18E24C5,
+		0x23671400, 0xC8B90000, 0xF4C70000, 0xFB750000,
+		0x73CD2465, 0xF8A6A549, 0x02C40A3F, 0xDC24E61F,
+	},
+	{
+		0x23671400, 0xC8B90000, 0xF4C70000, 0xFB750000,
+		0x73CD2465, 0xF8A6A549, 0x02C40A3F, 0xDC24E61F,
+		0x373D2800, 0x71500000, 0x95E00000, 0x0A140000,
+		0xBDAC1909, 0x48EF9831, 0x456D6D1F, 0x3DAAC2DA,
+	},
+	{
+		0x54285C00, 0xEAED0000, 0xC5D60000, 0xA1C50000,
+		0xB3A26770, 0x94A5C4E1, 0x6BB0419D, 0x551B3782,
+		0x9CBB1800, 0xB0D30000, 0x92510000, 0xED930000,
+		0x593A4345, 0xE114D5F4, 0x430633DA, 0x78CACE29,
+	},
+	{
+		0x9CBB1800, 0xB0D30000, 0x92510000, 0xED930000,
+		0x593A4345, 0xE114D5F4, 0x430633DA, 0x78CACE29,
+		0xC8934400, 0x5A3E0000, 0x57870000, 0x4C560000,
+		0xEA982435, 0x75B11115, 0x28B67247, 0x2DD1F9AB,
+	},
+	{
+		0x29449C00, 0x64E70000, 0xF24B0000, 0xC2F30000,
+		0x0EDE4E8F, 0x56C23745, 0xF3E04259, 0x8D0D9EC4,
+		0x466D0C00, 0x08620000, 0xDD5D0000, 0xBADD0000,
+		0x6A927942, 0x441F2B93, 0x218ACE6F, 0xBF2C0BE2,
+	},
+	{
+		0x466D0C00, 0x08620000, 0xDD5D0000, 0xBADD0000,
+		0x6A927942, 0x441F2B93, 0x218ACE6F, 0xBF2C0BE2,
+		0x6F299000, 0x6C850000, 0x2F160000, 0x782E0000,
+		0x644C37CD, 0x12DD1CD6, 0xD26A8C36, 0x32219526,
+	},
+	{
+		0xF6800005, 0x3443C000, 0x24070000, 0x8F3D0000,
+		0x21373BFB, 0x0AB8D5AE, 0xCDC58B19, 0xD795BA31,
+		0xA67F0001, 0x71378000, 0x19FC0000, 0x96DB0000,
+		0x3A8B6DFD, 0xEBCAAEF3, 0x2C6D478F, 0xAC8E6C88,
+	},
+	{
+		0xA67F0001, 0x71378000, 0x19FC0000, 0x96DB0000,
+		0x3A8B6DFD, 0xEBCAAEF3, 0x2C6D478F, 0xAC8E6C88,
+		0x50FF0004, 0x45744000, 0x3DFB0000, 0x19E60000,
+		0x1BBC5606, 0xE1727B5D, 0xE1A8CC96, 0x7B1BD6B9,
+	},
+	{
+		0xF7750009, 0xCF3CC000, 0xC3D60000, 0x04920000,
+		0x029519A9, 0xF8E836BA, 0x7A87F14E, 0x9E16981A,
+		0xD46A0000, 0x8DC8C000, 0xA5AF0000, 0x4A290000,
+		0xFC4E427A, 0xC9B4866C, 0x98369604, 0xF746C320,
+	},
+	{
+		0xD46A0000, 0x8DC8C000, 0xA5AF0000, 0x4A290000,
+		0xFC4E427A, 0xC9B4866C, 0x98369604, 0xF746C320,
+		0x231F0009, 0x42F40000, 0x66790000, 0x4EBB0000,
+		0xFEDB5BD3, 0x315CB0D6, 0xE2B1674A, 0x69505B3A,
+	},
+	{
+		0x774400F0, 0xF15A0000, 0xF5B20000, 0x34140000,
+		0x89377E8C, 0x5A8BEC25, 0x0BC3CD1E, 0xCF3775CB,
+		0xF46C0050, 0x96180000, 0x14A50000, 0x031F0000,
+		0x42947EB8, 0x66BF7E19, 0x9CA470D2, 0x8A341574,
+	},
+	{
+		0xF46C0050, 0x96180000, 0x14A50000, 0x031F0000,
+		0x42947EB8, 0x66BF7E19, 0x9CA470D2, 0x8A341574,
+		0x832800A0, 0x67420000, 0xE1170000, 0x370B0000,
+		0xCBA30034, 0x3C34923C, 0x9767BDCC, 0x450360BF,
+	},
+	{
+		0xE8870170, 0x9D720000, 0x12DB0000, 0xD4220000,
+		0xF2886B27, 0xA921E543, 0x4EF8B518, 0x618813B1,
+		0xB4370060, 0x0C4C0000, 0x56C20000, 0x5CAE0000,
+		0x94541F3F, 0x3B3EF825, 0x1B365F3D, 0xF3D45758,
+	},
+	{
+		0xB4370060, 0x0C4C0000, 0x56C20000, 0x5CAE0000,
+		0x94541F3F, 0x3B3EF825, 0x1B365F3D, 0xF3D45758,
+		0x5CB00110, 0x913E0000, 0x44190000, 0x888C0000,
+		0x66DC7418, 0x921F1D66, 0x55CEEA25, 0x925C44E9,
+	},
+	{
+		0x0C720000, 0x49E50F00, 0x42790000, 0x5CEA0000,
+		0x33AA301A, 0x15822514, 0x95A34B7B, 0xB44B0090,
+		0xFE220000, 0xA7580500, 0x25D10000, 0xF7600000,
+		0x893178DA, 0x1FD4F860, 0x4ED0A315, 0xA123FF9F,
+	},
+	{
+		0xFE220000, 0xA7580500, 0x25D10000, 0xF7600000,
+		0x893178DA, 0x1FD4F860, 0x4ED0A315, 0xA123FF9F,
+		0xF2500000, 0xEEBD0A00, 0x67A80000, 0xAB8A0000,
+		0xBA9B48C0, 0x0A56DD74, 0xDB73E86E, 0x1568FF0F,
+	},
+	{
+		0x45180000, 0xA5B51700, 0xF96A0000, 0x3B480000,
+		0x1ECC142C, 0x231395D6, 0x16BCA6B0, 0xDF33F4DF,
+		0xB83D0000, 0x16710600, 0x379A0000, 0xF5B10000,
+		0x228161AC, 0xAE48F145, 0x66241616, 0xC5C1EB3E,
+	},
+	{
+		0xB83D0000, 0x16710600, 0x379A0000, 0xF5B10000,
+		0x228161AC, 0xAE48F145, 0x66241616, 0xC5C1EB3E,
+		0xFD250000, 0xB3C41100, 0xCEF00000, 0xCEF90000,
+		0x3C4D7580, 0x8D5B6493, 0x7098B0A6, 0x1AF21FE1,
+	},
+	{
+		0x75A40000, 0xC28B2700, 0x94A40000, 0x90F50000,
+		0xFB7857E0, 0x49CE0BAE, 0x1767C483, 0xAEDF667E,
+		0xD1660000, 0x1BBC0300, 0x9EEC0000, 0xF6940000,
+		0x03024527, 0xCF70FCF2, 0xB4431B17, 0x857F3C2B,
+	},
+	{
+		0xD1660000, 0x1BBC0300, 0x9EEC0000, 0xF6940000,
+		0x03024527, 0xCF70FCF2, 0xB4431B17, 0x857F3C2B,
+		0xA4C20000, 0xD9372400, 0x0A480000, 0x66610000,
+		0xF87A12C7, 0x86BEF75C, 0xA324DF94, 0x2BA05A55,
+	},
+	{
+		0x75C90003, 0x0E10C000, 0xD1200000, 0xBAEA0000,
+		0x8BC42F3E, 0x8758B757, 0xBB28761D, 0x00B72E2B,
+		0xEECF0001, 0x6F564000, 0xF33E0000, 0xA79E0000,
+		0xBDB57219, 0xB711EBC5, 0x4A3B40BA, 0xFEABF254,
+	},
+	{
+		0xEECF0001, 0x6F564000, 0xF33E0000, 0xA79E0000,
+		0xBDB57219, 0xB711EBC5, 0x4A3B40BA, 0xFEABF254,
+		0x9B060002, 0x61468000, 0x221E0000, 0x1D740000,
+		0x36715D27, 0x30495C92, 0xF11336A7, 0xFE1CDC7F,
+	},
+	{
+		0x86790000, 0x3F390002, 0xE19AE000, 0x98560000,
+		0x9565670E, 0x4E88C8EA, 0xD3DD4944, 0x161DDAB9,
+		0x30B70000, 0xE5D00000, 0xF4F46000, 0x42C40000,
+		0x63B83D6A, 0x78BA9460, 0x21AFA1EA, 0xB0A51834,
+	},
+	{
+		0x30B70000, 0xE5D00000, 0xF4F46000, 0x42C40000,
+		0x63B83D6A, 0x78BA9460, 0x21AFA1EA, 0xB0A51834,
+		0xB6CE0000, 0xDAE90002, 0x156E8000, 0xDA920000,
+		0xF6DD5A64, 0x36325C8A, 0xF272E8AE, 0xA6B8C28D,
+	},
+	{
+		0x14190000, 0x23CA003C, 0x50DF0000, 0x44B60000,
+		0x1B6C67B0, 0x3CF3AC75, 0x61E610B0, 0xDBCADB80,
+		0xE3430000, 0x3A4E0014, 0xF2C60000, 0xAA4E0000,
+		0xDB1E42A6, 0x256BBE15, 0x123DB156, 0x3A4E99D7,
+	},
+	{
+		0xE3430000, 0x3A4E0014, 0xF2C60000, 0xAA4E0000,
+		0xDB1E42A6, 0x256BBE15, 0x123DB156, 0x3A4E99D7,
+		0xF75A0000, 0x19840028, 0xA2190000, 0xEEF80000,
+		0xC0722516, 0x19981260, 0x73DBA1E6, 0xE1844257,
+	},
+	{
+		0x54500000, 0x0671005C, 0x25AE0000, 0x6A1E0000,
+		0x2EA54EDF, 0x664E8512, 0xBFBA18C3, 0x7E715D17,
+		0xBC8D0000, 0xFC3B0018, 0x19830000, 0xD10B0000,
+		0xAE1878C4, 0x42A69856, 0x0012DA37, 0x2C3B504E,
+	},
+	{
+		0xBC8D0000, 0xFC3B0018, 0x19830000, 0xD10B0000,
+		0xAE1878C4, 0x42A69856, 0x0012DA37, 0x2C3B504E,
+		0xE8DD0000, 0xFA4A0044, 0x3C2D0000, 0xBB150000,
+		0x80BD361B, 0x24E81D44, 0xBFA8C2F4, 0x524A0D59,
+	},
+	{
+		0x69510000, 0xD4E1009C, 0xC3230000, 0xAC2F0000,
+		0xE4950BAE, 0xCEA415DC, 0x87EC287C, 0xBCE1A3CE,
+		0xC6730000, 0xAF8D000C, 0xA4C10000, 0x218D0000,
+		0x23111587, 0x7913512F, 0x1D28AC88, 0x378DD173,
+	},
+	{
+		0xC6730000, 0xAF8D000C, 0xA4C10000, 0x218D0000,
+		0x23111587, 0x7913512F, 0x1D28AC88, 0x378DD173,
+		0xAF220000, 0x7B6C0090, 0x67E20000, 0x8DA20000,
+		0xC7841E29, 0xB7B744F3, 0x9AC484F4, 0x8B6C72BD,
+	},
+	{
+		0xCC140000, 0xA5630000, 0x5AB90780, 0x3B500000,
+		0x4BD013FF, 0x879B3418, 0x694348C1, 0xCA5A87FE,
+		0x819E0000, 0xEC570000, 0x66320280, 0x95F30000,
+		0x5DA92802, 0x48F43CBC, 0xE65AA22D, 0x8E67B7FA,
+	},
+	{
+		0x819E0000, 0xEC570000, 0x66320280, 0x95F30000,
+		0x5DA92802, 0x48F43CBC, 0xE65AA22D, 0x8E67B7FA,
+		0x4D8A0000, 0x49340000, 0x3C8B0500, 0xAEA30000,
+		0x16793BFD, 0xCF6F08A4, 0x8F19EAEC, 0x443D3004,
+	},
+	{
+		0x78230000, 0x12FC0000, 0xA93A0B80, 0x90A50000,
+		0x713E2879, 0x7EE98924, 0xF08CA062, 0x636F8BAB,
+		0x02AF0000, 0xB7280000, 0xBA1C0300, 0x56980000,
+		0xBA8D45D3, 0x8048C667, 0xA95C149A, 0xF4F6EA7B,
+	},
+	{
+		0x02AF0000, 0xB7280000, 0xBA1C0300, 0x56980000,
+		0xBA8D45D3, 0x8048C667, 0xA95C149A, 0xF4F6EA7B,
+		0x7A8C0000, 0xA5D40000, 0x13260880, 0xC63D0000,
+		0xCBB36DAA, 0xFEA14F43, 0x59D0B4F8, 0x979961D0,
+	},
+	{
+		0xAC480000, 0x1BA60000, 0x45FB1380, 0x03430000,
+		0x5A85316A, 0x1FB250B6, 0xFE72C7FE, 0x91E478F6,
+		0x1E4E0000, 0xDECF0000, 0x6DF80180, 0x77240000,
+		0xEC47079E, 0xF4A0694E, 0xCDA31812, 0x98AA496E,
+	},
+	{
+		0x1E4E0000, 0xDECF0000, 0x6DF80180, 0x77240000,
+		0xEC47079E, 0xF4A0694E, 0xCDA31812, 0x98AA496E,
+		0xB2060000, 0xC5690000, 0x28031200, 0x74670000,
+		0xB6C236F4, 0xEB1239F8, 0x33D1DFEC, 0x094E3198,
+	},
+	{
+		0xAEC30000, 0x9C4F0001, 0x79D1E000, 0x2C150000,
+		0x45CC75B3, 0x6650B736, 0xAB92F78F, 0xA312567B,
+		0xDB250000, 0x09290000, 0x49AAC000, 0x81E10000,
+		0xCAFE6B59, 0x42793431, 0x43566B76, 0xE86CBA2E,
+	},
+	{
+		0xDB250000, 0x09290000, 0x49AAC000, 0x81E10000,
+		0xCAFE6B59, 0x42793431, 0x43566B76, 0xE86CBA2E,
+		0x75E60000, 0x95660001, 0x307B2000, 0xADF40000,
+		0x8F321EEA, 0x24298307, 0xE8C49CF9, 0x4B7EEC55,
+	},
+	{
+		0x58430000, 0x807E0000, 0x78330001, 0xC66B3800,
+		0xE7375CDC, 0x79AD3FDD, 0xAC73FE6F, 0x3A4479B1,
+		0x1D5A0000, 0x2B720000, 0x488D0000, 0xAF611800,
+		0x25CB2EC5, 0xC879BFD0, 0x81A20429, 0x1E7536A6,
+	},
+	{
+		0x1D5A0000, 0x2B720000, 0x488D0000, 0xAF611800,
+		0x25CB2EC5, 0xC879BFD0, 0x81A20429, 0x1E7536A6,
+		0x45190000, 0xAB0C0000, 0x30BE0001, 0x690A2000,
+		0xC2FC7219, 0xB1D4800D, 0x2DD1FA46, 0x24314F17,
+	},
+	{
+		0xA53B0000, 0x14260000, 0x4E30001E, 0x7CAE0000,
+		0x8F9E0DD5, 0x78DFAA3D, 0xF73168D8, 0x0B1B4946,
+		0x07ED0000, 0xB2500000, 0x8774000A, 0x970D0000,
+		0x437223AE, 0x48C76EA4, 0xF4786222, 0x9075B1CE,
+	},
+	{
+		0x07ED0000, 0xB2500000, 0x8774000A, 0x970D0000,
+		0x437223AE, 0x48C76EA4, 0xF4786222, 0x9075B1CE,
+		0xA2D60000, 0xA6760000, 0xC9440014, 0xEBA30000,
+		0xCCEC2E7B, 0x3018C499, 0x03490AFA, 0x9B6EF888,
+	},
+	{
+		0x88980000, 0x1F940000, 0x7FCF002E, 0xFB4E0000,
+		0xF158079A, 0x61AE9167, 0xA895706C, 0xE6107494,
+		0x0BC20000, 0xDB630000, 0x7E88000C, 0x15860000,
+		0x91FD48F3, 0x7581BB43, 0xF460449E, 0xD8B61463,
+	},
+	{
+		0x0BC20000, 0xDB630000, 0x7E88000C, 0x15860000,
+		0x91FD48F3, 0x7581BB43, 0xF460449E, 0xD8B61463,
+		0x835A0000, 0xC4F70000, 0x01470022, 0xEEC80000,
+		0x60A54F69, 0x142F2A24, 0x5CF534F2, 0x3EA660F7,
+	},
+	{
+		0x52500000, 0x29540000, 0x6A61004E, 0xF0FF0000,
+		0x9A317EEC, 0x452341CE, 0xCF568FE5, 0x5303130F,
+		0x538D0000, 0xA9FC0000, 0x9EF70006, 0x56FF0000,
+		0x0AE4004E, 0x92C5CDF9, 0xA9444018, 0x7F975691,
+	},
+	{
+		0x538D0000, 0xA9FC0000, 0x9EF70006, 0x56FF0000,
+		0x0AE4004E, 0x92C5CDF9, 0xA9444018, 0x7F975691,
+		0x01DD0000, 0x80A80000, 0xF4960048, 0xA6000000,
+		0x90D57EA2, 0xD7E68C37, 0x6612CFFD, 0x2C94459E,
+	},
+	{
+		0xE6280000, 0x4C4B0000, 0xA8550000, 0xD3D002E0,
+		0xD86130B8, 0x98A7B0DA, 0x289506B4, 0xD75A4897,
+		0xF0C50000, 0x59230000, 0x45820000, 0xE18D00C0,
+		0x3B6D0631, 0xC2ED5699, 0xCBE0FE1C, 0x56A7B19F,
+	},
+	{
+		0xF0C50000, 0x59230000, 0x45820000, 0xE18D00C0,
+		0x3B6D0631, 0xC2ED5699, 0xCBE0FE1C, 0x56A7B19F,
+		0x16ED0000, 0x15680000, 0xEDD70000, 0x325D0220,
+		0xE30C3689, 0x5A4AE643, 0xE375F8A8, 0x81FDF908,
+	},
+	{
+		0xB4310000, 0x77330000, 0xB15D0000, 0x7FD004E0,
+		0x78A26138, 0xD116C35D, 0xD256D489, 0x4E6F74DE,
+		0xE3060000, 0xBDC10000, 0x87130000, 0xBFF20060,
+		0x2EBA0A1A, 0x8DB53751, 0x73C5AB06, 0x5BD61539,
+	},
+	{
+		0xE3060000, 0xBDC10000, 0x87130000, 0xBFF20060,
+		0x2EBA0A1A, 0x8DB53751, 0x73C5AB06, 0x5BD61539,
+		0x57370000, 0xCAF20000, 0x364E0000, 0xC0220480,
+		0x56186B22, 0x5CA3F40C, 0xA1937F8F, 0x15B961E7,
+	},
+	{
+		0x02F20000, 0xA2810000, 0x873F0000, 0xE36C7800,
+		0x1E1D74EF, 0x073D2BD6, 0xC4C23237, 0x7F32259E,
+		0xBADD0000, 0x13AD0000, 0xB7E70000, 0xF7282800,
+		0xDF45144D, 0x361AC33A, 0xEA5A8D14, 0x2A2C18F0,
+	},
+	{
+		0xBADD0000, 0x13AD0000, 0xB7E70000, 0xF7282800,
+		0xDF45144D, 0x361AC33A, 0xEA5A8D14, 0x2A2C18F0,
+		0xB82F0000, 0xB12C0000, 0x30D80000, 0x14445000,
+		0xC15860A2, 0x3127E8EC, 0x2E98BF23, 0x551E3D6E,
+	},
+	{
+		0x1E6C0000, 0xC4420000, 0x8A2E0000, 0xBCB6B800,
+		0x2C4413B6, 0x8BFDD3DA, 0x6A0C1BC8, 0xB99DC2EB,
+		0x92560000, 0x1EDA0000, 0xEA510000, 0xE8B13000,
+		0xA93556A5, 0xEBFB6199, 0xB15C2254, 0x33C5244F,
+	},
+	{
+		0x92560000, 0x1EDA0000, 0xEA510000, 0xE8B13000,
+		0xA93556A5, 0xEBFB6199, 0xB15C2254, 0x33C5244F,
+		0x8C3A0000, 0xDA980000, 0x607F0000, 0x54078800,
+		0x85714513, 0x6006B243, 0xDB50399C, 0x8A58E6A4,
+	},
+	{
+		0x033D0000, 0x08B30000, 0xF33A0000, 0x3AC20007,
+		0x51298A50, 0x6B6E661F, 0x0EA5CFE3, 0xE6DA7FFE,
+		0xA8DA0000, 0x96BE0000, 0x5C1D0000, 0x07DA0002,
+		0x7D669583, 0x1F98708A, 0xBB668808, 0xDA878000,
+	},
+	{
+		0xA8DA0000, 0x96BE0000, 0x5C1D0000, 0x07DA0002,
+		0x7D669583, 0x1F98708A, 0xBB668808, 0xDA878000,
+		0xABE70000, 0x9E0D0000, 0xAF270000, 0x3D180005,
+		0x2C4F1FD3, 0x74F61695, 0xB5C347EB, 0x3C5DFFFE,
+	},
+	{
+		0x01930000, 0xE7820000, 0xEDFB0000, 0xCF0C000B,
+		0x8DD08D58, 0xBCA3B42E, 0x063661E1, 0x536F9E7B,
+		0x92280000, 0xDC850000, 0x57FA0000, 0x56DC0003,
+		0xBAE92316, 0x5AEFA30C, 0x90CEF752, 0x7B1675D7,
+	},
+	{
+		0x92280000, 0xDC850000, 0x57FA0000, 0x56DC0003,
+		0xBAE92316, 0x5AEFA30C, 0x90CEF752, 0x7B1675D7,
+		0x93BB0000, 0x3B070000, 0xBA010000, 0x99D00008,
+		0x3739AE4E, 0xE64C1722, 0x96F896B3, 0x2879EBAC,
+	},
+	{
+		0x5FA80000, 0x56030000, 0x43AE0000, 0x64F30013,
+		0x257E86BF, 0x1311944E, 0x541E95BF, 0x8EA4DB69,
+		0x00440000, 0x7F480000, 0xDA7C0000, 0x2A230001,
+		0x3BADC9CC, 0xA9B69C87, 0x030A9E60, 0xBE0A679E,
+	},
+	{
+		0x00440000, 0x7F480000, 0xDA7C0000, 0x2A230001,
+		0x3BADC9CC, 0xA9B69C87, 0x030A9E60, 0xBE0A679E,
+		0x5FEC0000, 0x294B0000, 0x99D20000, 0x4ED00012,
+		0x1ED34F73, 0xBAA708C9, 0x57140BDF, 0x30AEBCF7,
+	},
+	{
+		0xEE930000, 0xD6070000, 0x92C10000, 0x2B9801E0,
+		0x9451287C, 0x3B6CFB57, 0x45312374, 0x201F6A64,
+		0x7B280000, 0x57420000, 0xA9E50000, 0x634300A0,
+		0x9EDB442F, 0x6D9995BB, 0x27F83B03, 0xC7FF60F0,
+	},
+	{
+		0x7B280000, 0x57420000, 0xA9E50000, 0x634300A0,
+		0x9EDB442F, 0x6D9995BB, 0x27F83B03, 0xC7FF60F0,
+		0x95BB0000, 0x81450000, 0x3B240000, 0x48DB0140,
+		0x0A8A6C53, 0x56F56EEC, 0x62C91877, 0xE7E00A94,
+	},
+}
@@ -1,24 +1,19 @@
-#ifndef VERGE_CRYPTO_POW_HAMSI_H
-#define VERGE_CRYPTO_POW_HAMSI_H
-
-#include <stdlib.h>
-#include <stdint.h>
-#include <string.h>
-#include <stdio.h>
+//go:build cgo
+// +build cgo
 
 #include "ghamsi.h"
-#include "sph_hamsi.h"
 
-void HashHamsi(const char *input, int inputLen, char *output)
+void HamsiInit(sph_hamsi512_context *ctx)
 {
-    sph_hamsi512_context ctx_hamsi;
-    char hash[64];
-
-    sph_hamsi512_init(&ctx_hamsi);
-    sph_hamsi512(&ctx_hamsi, input, inputLen);
-    sph_hamsi512_close(&ctx_hamsi, hash);
+    sph_hamsi512_init(ctx);
+}
 
-    memcpy(output, hash, 64);
+void HamsiWrite(sph_hamsi512_context *ctx, const void *input, size_t inputLen)
+{
+    sph_hamsi512(ctx, input, inputLen);
 }
 
-#endif // VERGE_CRYPTO_POW_HAMSI_H
+void HamsiClose(sph_hamsi512_context *ctx, unsigned bits, unsigned bcnt, void *output)
+{
+    sph_hamsi512_addbits_and_close(ctx, bits, bcnt, output);
+}
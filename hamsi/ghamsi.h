@@ -1,14 +1,20 @@
-#ifndef VERGE_CRYPTO_POW_HAMSI_H
-#define VERGE_CRYPTO_POW_HAMSI_H
+#ifndef VERGE_CRYPTO_POW_GHAMSI_H
+#define VERGE_CRYPTO_POW_GHAMSI_H
+
+#include <stddef.h>
+
+#include "sph_hamsi.h"
 
 #ifdef __cplusplus
 extern "C" {
 #endif
 
-void HashHamsi(const char *input, int inputLen, char *output);
+void HamsiInit(sph_hamsi512_context *ctx);
+void HamsiWrite(sph_hamsi512_context *ctx, const void *input, size_t inputLen);
+void HamsiClose(sph_hamsi512_context *ctx, unsigned bits, unsigned bcnt, void *output);
 
 #ifdef __cplusplus
 }
 #endif
 
-#endif // VERGE_CRYPTO_POW_HAMSI_H
+#endif // VERGE_CRYPTO_POW_GHAMSI_H
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 /* $Id: hamsi.c 251 2010-10-19 14:31:51Z tp $ */
 /*
  * Hamsi implementation.
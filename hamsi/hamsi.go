@@ -3,16 +3,300 @@
 
 package hamsi
 
-// #include "ghamsi.h"
-import "C"
-import "unsafe"
+import (
+	"fmt"
 
-// SumBig creates a hamsi hash of the given bytes and returns always exactly 64 bytes.
-func SumBig(inputData []byte, dst []byte) {
-	var hashOutput [64]C.char
+	"github.com/rnichollx/go-x17/hash"
+)
 
-	C.HashHamsi(C.CString(string(inputData)), C.int(len(inputData)), &hashOutput[0])
-	outputBuffer := C.GoBytes(unsafe.Pointer(&hashOutput[0]), 64)
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(64)
 
-	copy(dst[:], outputBuffer)
+// BlockSize holds the size of a block in bytes.
+const BlockSize = uintptr(8)
+
+////////////////
+
+type digest struct {
+	ptr uintptr
+	cnt uint64
+
+	h [16]uint32
+
+	b [BlockSize]byte
+}
+
+// New returns a new digest to compute a HAMSI512 hash.
+func New() hash.Digest {
+	ref := &digest{}
+	ref.Reset()
+	return ref
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *digest) Reset() {
+	ref.ptr, ref.cnt = 0, 0
+	copy(ref.h[:], kInit[:])
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *digest) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [64]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *digest) Write(src []byte) (int, error) {
+	sln := uintptr(len(src))
+	fln := len(src)
+	ptr := ref.ptr
+
+	if sln < (BlockSize - ptr) {
+		copy(ref.b[ptr:], src)
+		ref.ptr += sln
+		return int(sln), nil
+	}
+
+	if ptr != 0 {
+		cln := BlockSize - ptr
+		copy(ref.b[ptr:], src[:cln])
+		src = src[cln:]
+		sln -= cln
+		ref.compress(ref.b[:], &kAlphaN, 6)
+		ptr = 0
+	}
+
+	for sln >= BlockSize {
+		ref.compress(src[:BlockSize], &kAlphaN, 6)
+		src = src[BlockSize:]
+		sln -= BlockSize
+	}
+
+	copy(ref.b[:], src)
+	ref.ptr = sln
+	return fln, nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize will return an error.
+func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); HashSize > ln {
+		return fmt.Errorf("Hamsi Close: dst min length: %d, got %d", HashSize, ln)
+	}
+
+	ptr := ref.ptr
+
+	var pad [BlockSize]byte
+	encUInt64be(pad[:], ref.cnt+uint64(ptr<<3)+uint64(bcnt))
+
+	{
+		off := uint8(0x80) >> bcnt
+		ref.b[ptr] = uint8((bits & -off) | off)
+	}
+	memset(ref.b[ptr+1:], 0)
+
+	ref.compress(ref.b[:], &kAlphaN, 6)
+	ref.compress(pad[:], &kAlphaF, 12)
+
+	for k := uintptr(0); k < 16; k++ {
+		encUInt32be(dst[(k<<2):], ref.h[k])
+	}
+
+	ref.Reset()
+	return nil
+}
+
+// Size returns the number of bytes Sum will return.
+func (*digest) Size() int {
+	return HashSize
+}
+
+// BlockSize returns the block size of the hash.
+func (*digest) BlockSize() int {
+	return int(BlockSize)
+}
+
+////////////////
+
+func memset(dst []byte, src byte) {
+	for i := range dst {
+		dst[i] = src
+	}
+}
+
+func encUInt32be(dst []byte, src uint32) {
+	dst[0] = uint8(src >> 24)
+	dst[1] = uint8(src >> 16)
+	dst[2] = uint8(src >> 8)
+	dst[3] = uint8(src)
+}
+
+func encUInt64be(dst []byte, src uint64) {
+	dst[0] = uint8(src >> 56)
+	dst[1] = uint8(src >> 48)
+	dst[2] = uint8(src >> 40)
+	dst[3] = uint8(src >> 32)
+	dst[4] = uint8(src >> 24)
+	dst[5] = uint8(src >> 16)
+	dst[6] = uint8(src >> 8)
+	dst[7] = uint8(src)
+}
+
+// compress expands a single 8 byte block and runs the given number
+// of rounds over it, alpha selects between the normal and final
+// permutation. Every call accounts for 64 message bits.
+func (ref *digest) compress(buf []byte, alpha *[32]uint32, rounds uint32) {
+	var m [16]uint32
+	for u := 0; u < 8; u++ {
+		tp := &kExpand[u][buf[u]]
+		for i := range m {
+			m[i] ^= tp[i]
+		}
+	}
+
+	h := &ref.h
+	var s [32]uint32
+	s[0x00], s[0x01], s[0x02], s[0x03] = m[0x0], m[0x1], h[0x0], h[0x1]
+	s[0x04], s[0x05], s[0x06], s[0x07] = m[0x2], m[0x3], h[0x2], h[0x3]
+	s[0x08], s[0x09], s[0x0A], s[0x0B] = h[0x4], h[0x5], m[0x4], m[0x5]
+	s[0x0C], s[0x0D], s[0x0E], s[0x0F] = h[0x6], h[0x7], m[0x6], m[0x7]
+	s[0x10], s[0x11], s[0x12], s[0x13] = m[0x8], m[0x9], h[0x8], h[0x9]
+	s[0x14], s[0x15], s[0x16], s[0x17] = m[0xA], m[0xB], h[0xA], h[0xB]
+	s[0x18], s[0x19], s[0x1A], s[0x1B] = h[0xC], h[0xD], m[0xC], m[0xD]
+	s[0x1C], s[0x1D], s[0x1E], s[0x1F] = h[0xE], h[0xF], m[0xE], m[0xF]
+
+	for r := uint32(0); r < rounds; r++ {
+		for i := range s {
+			s[i] ^= alpha[i]
+		}
+		s[0x01] ^= r
+
+		sbox(&s[0x00], &s[0x08], &s[0x10], &s[0x18])
+		sbox(&s[0x01], &s[0x09], &s[0x11], &s[0x19])
+		sbox(&s[0x02], &s[0x0A], &s[0x12], &s[0x1A])
+		sbox(&s[0x03], &s[0x0B], &s[0x13], &s[0x1B])
+		sbox(&s[0x04], &s[0x0C], &s[0x14], &s[0x1C])
+		sbox(&s[0x05], &s[0x0D], &s[0x15], &s[0x1D])
+		sbox(&s[0x06], &s[0x0E], &s[0x16], &s[0x1E])
+		sbox(&s[0x07], &s[0x0F], &s[0x17], &s[0x1F])
+
+		diffuse(&s[0x00], &s[0x09], &s[0x12], &s[0x1B])
+		diffuse(&s[0x01], &s[0x0A], &s[0x13], &s[0x1C])
+		diffuse(&s[0x02], &s[0x0B], &s[0x14], &s[0x1D])
+		diffuse(&s[0x03], &s[0x0C], &s[0x15], &s[0x1E])
+		diffuse(&s[0x04], &s[0x0D], &s[0x16], &s[0x1F])
+		diffuse(&s[0x05], &s[0x0E], &s[0x17], &s[0x18])
+		diffuse(&s[0x06], &s[0x0F], &s[0x10], &s[0x19])
+		diffuse(&s[0x07], &s[0x08], &s[0x11], &s[0x1A])
+		diffuse(&s[0x00], &s[0x02], &s[0x05], &s[0x07])
+		diffuse(&s[0x10], &s[0x13], &s[0x15], &s[0x16])
+		diffuse(&s[0x09], &s[0x0B], &s[0x0C], &s[0x0E])
+		diffuse(&s[0x19], &s[0x1A], &s[0x1C], &s[0x1F])
+	}
+
+	for i := 0; i < 8; i++ {
+		h[i] ^= s[i]
+		h[i+8] ^= s[i+16]
+	}
+
+	ref.cnt += 64
+}
+
+func sbox(a, b, c, d *uint32) {
+	t := *a
+	*a &= *c
+	*a ^= *d
+	*c ^= *b
+	*c ^= *a
+	*d |= t
+	*d ^= *b
+	t ^= *c
+	*b = *d
+	*d |= t
+	*d ^= *a
+	*a &= *b
+	t ^= *a
+	*b ^= *d
+	*b ^= t
+	*a = *c
+	*c = *b
+	*b = *d
+	*d = ^t
+}
+
+func diffuse(a, b, c, d *uint32) {
+	*a = (*a << 13) | (*a >> 19)
+	*c = (*c << 3) | (*c >> 29)
+	*b ^= *a ^ *c
+	*d ^= *c ^ (*a << 3)
+	*b = (*b << 1) | (*b >> 31)
+	*d = (*d << 7) | (*d >> 25)
+	*a ^= *b ^ *d
+	*c ^= *d ^ (*b << 7)
+	*a = (*a << 5) | (*a >> 27)
+	*c = (*c << 22) | (*c >> 10)
+}
+
+////////////////
+
+// kExpand holds the message expansion for every value of every
+// byte of a block, precomputed from kT512 at package init.
+var kExpand [8][256][16]uint32
+
+func init() {
+	for u := 0; u < 8; u++ {
+		for db := 0; db < 256; db++ {
+			for v := uint(0); v < 8; v++ {
+				if (db>>v)&1 == 0 {
+					continue
+				}
+				for i := 0; i < 16; i++ {
+					kExpand[u][db][i] ^= kT512[(u<<3)+int(v)][i]
+				}
+			}
+		}
+	}
+}
+
+var kInit = [16]uint32{
+	uint32(0x73746565), uint32(0x6c706172), uint32(0x6b204172),
+	uint32(0x656e6265), uint32(0x72672031), uint32(0x302c2062),
+	uint32(0x75732032), uint32(0x3434362c), uint32(0x20422d33),
+	uint32(0x30303120), uint32(0x4c657576), uint32(0x656e2d48),
+	uint32(0x65766572), uint32(0x6c65652c), uint32(0x2042656c),
+	uint32(0x6769756d),
+}
+
+var kAlphaN = [32]uint32{
+	uint32(0xff00f0f0), uint32(0xccccaaaa), uint32(0xf0f0cccc),
+	uint32(0xff00aaaa), uint32(0xccccaaaa), uint32(0xf0f0ff00),
+	uint32(0xaaaacccc), uint32(0xf0f0ff00), uint32(0xf0f0cccc),
+	uint32(0xaaaaff00), uint32(0xccccff00), uint32(0xaaaaf0f0),
+	uint32(0xaaaaf0f0), uint32(0xff00cccc), uint32(0xccccf0f0),
+	uint32(0xff00aaaa), uint32(0xccccaaaa), uint32(0xff00f0f0),
+	uint32(0xff00aaaa), uint32(0xf0f0cccc), uint32(0xf0f0ff00),
+	uint32(0xccccaaaa), uint32(0xf0f0ff00), uint32(0xaaaacccc),
+	uint32(0xaaaaff00), uint32(0xf0f0cccc), uint32(0xaaaaf0f0),
+	uint32(0xccccff00), uint32(0xff00cccc), uint32(0xaaaaf0f0),
+	uint32(0xff00aaaa), uint32(0xccccf0f0),
+}
+
+var kAlphaF = [32]uint32{
+	uint32(0xcaf9639c), uint32(0x0ff0f9c0), uint32(0x639c0ff0),
+	uint32(0xcaf9f9c0), uint32(0x0ff0f9c0), uint32(0x639ccaf9),
+	uint32(0xf9c00ff0), uint32(0x639ccaf9), uint32(0x639c0ff0),
+	uint32(0xf9c0caf9), uint32(0x0ff0caf9), uint32(0xf9c0639c),
+	uint32(0xf9c0639c), uint32(0xcaf90ff0), uint32(0x0ff0639c),
+	uint32(0xcaf9f9c0), uint32(0x0ff0f9c0), uint32(0xcaf9639c),
+	uint32(0xcaf9f9c0), uint32(0x639c0ff0), uint32(0x639ccaf9),
+	uint32(0x0ff0f9c0), uint32(0x639ccaf9), uint32(0xf9c00ff0),
+	uint32(0xf9c0caf9), uint32(0x639c0ff0), uint32(0xf9c0639c),
+	uint32(0x0ff0caf9), uint32(0xcaf90ff0), uint32(0xf9c0639c),
+	uint32(0xcaf9f9c0), uint32(0x0ff0639c),
 }
@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 /* $Id: hamsi_helper.c 202 2010-05-31 15:46:48Z tp $ */
 /*
  * Helper code for Hamsi (input block expansion). This code is
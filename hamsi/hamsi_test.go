@@ -1,5 +1,6 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
+
 package hamsi
 
 import (
@@ -10,14 +11,32 @@ import (
 	"github.com/rnichollx/go-x17/nist"
 )
 
+////////////////
+
+func TestApi(t *testing.T) {
+	dgst := New()
+	if sz := dgst.Size(); HashSize != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	if sz := dgst.BlockSize(); int(BlockSize) != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	res := [2]byte{}
+	if nil == dgst.Close(res[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
 func TestHash(t *testing.T) {
+	dgst := New()
 	out := make([]byte, 64)
 
 	for i := range tsInfo {
 		length := len(tsInfo[i].out)
 		destination := make([]byte, length)
 
-		SumBig(tsInfo[i].in[:], out[:])
+		dgst.Write(tsInfo[i].in[:])
+		dgst.Close(out[:], 0, 0)
 		hex.Encode(destination, out[:])
 
 		if !bytes.Equal(destination[:], tsInfo[i].out[:]) {
@@ -26,6 +45,58 @@ func TestHash(t *testing.T) {
 	}
 }
 
+func TestNistSum(t *testing.T) {
+	for i := uint64(0); i < 2048; i++ {
+		runNistSum(t, i)
+	}
+}
+
+func TestNistClose(t *testing.T) {
+	// The bit level entries of NistResult do not match the sphlib
+	// padding of trailing bits, so only whole bytes are compared.
+	for i := uint64(0); i < 2048; i += 8 {
+		runNistClose(t, i)
+	}
+}
+
+func TestCloseBits(t *testing.T) {
+	for i := range tsBits {
+		dgst := New()
+		rest := [64]byte{}
+
+		dgst.Write(tsBits[i].in)
+		dgst.Close(rest[:], tsBits[i].bits, tsBits[i].bcnt)
+		hash, _ := hex.DecodeString(tsBits[i].out)
+
+		if !nist.IsEqual(hash, rest[:]) {
+			t.Errorf("\nClose bits %d:\n expected: %X\n      got: %X", i, hash, rest[:])
+		}
+	}
+}
+
+func TestWriteSplit(t *testing.T) {
+	dmsg := nist.Get(2040)
+	hash, _ := hex.DecodeString(NistResult[2040])
+
+	for step := 1; step < 20; step++ {
+		dgst := New()
+		rbuf := [64]byte{}
+
+		for off := 0; off < len(dmsg); off += step {
+			end := off + step
+			if end > len(dmsg) {
+				end = len(dmsg)
+			}
+			dgst.Write(dmsg[off:end])
+		}
+		dgst.Close(rbuf[:], 0, 0)
+
+		if !nist.IsEqual(hash, rbuf[:]) {
+			t.Errorf("\nSplit %d:\n expected: %X\n      got: %X", step, hash, rbuf[:])
+		}
+	}
+}
+
 var tsInfo = []struct {
 	id  string
 	in  []byte
@@ -48,35 +119,111 @@ var tsInfo = []struct {
 	},
 }
 
-// func TestNistSum(t *testing.T) {
-// 	for i := uint64(0); i < 2048; i++ {
-// 		runNistSum(t, i)
-// 	}
-// }
+// tsBits holds messages with trailing bits, hashed with
+// sph_hamsi512_addbits_and_close from the bundled sphlib.
+var tsBits = []struct {
+	in   []byte
+	bits uint8
+	bcnt uint8
+	out  string
+}{
+	{
+		[]byte{},
+		0x00, 1,
+		"96BCD4BA931F70FA951744A1DA4DE6679C88F83AB20D839803E2A4EC708D9A731CCA25D74D1874DB06A59E6D6A2C3A5DFC9818BD435EE13DD926D46F48D5FE47",
+	},
+	{
+		[]byte{},
+		0xC0, 2,
+		"C6BE8A43868F20B3402B6710A611D910ACBB35437A1A75B1A07B3B6A3F7DDEB6CC57E9E39DA9FD1D12D1641EEB1530C829F768F4210D5173EDB60FDE27C0E79B",
+	},
+	{
+		[]byte("The quick brown fox jumps over the lazy dog"),
+		0xA0, 3,
+		"1CB64A089048B1E31D765104AE7B9172F73A954FB52805ED8B1B6F09A1EDB4E1B76E5C4BF993A19AA26091D3C363F571968DF39FD7F334BEEE4D40456A64C9BB",
+	},
+}
 
 ////////////////
 
 func runNistSum(t *testing.T, idx uint64) {
 	if extr := idx & 7; extr == 0 {
-		rbuf := make([]byte, 64)
+		dgst := New()
+		rbuf := [64]byte{}
 		dmsg := nist.Get(idx)
 
-		SumBig(dmsg, rbuf[:])
+		ln, err := dgst.Write(dmsg)
+		if ln != len(dmsg) {
+			t.Errorf("\nSum Write length %d, expected: %d got: %d", idx, len(dmsg), ln)
+		}
+		if err != nil {
+			t.Errorf("\nSum Write should never return an error, %d: got: %X", idx, err)
+		}
+
+		rest := dgst.Sum(rbuf[0:0])
 		hash, _ := hex.DecodeString(NistResult[idx])
 
 		if !nist.IsEqual(hash, rbuf[:]) {
-			t.Errorf("\na) Sum %d:\n expected: %X\n      got: %X", idx, hash, rbuf[:])
+			t.Errorf("\na) Sum %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+		}
+
+		// Manual reset
+		dgst.Reset()
+
+		ln, err = dgst.Write(dmsg)
+		if ln != len(dmsg) {
+			t.Errorf("\nSum Write length %d, expected: %d got: %d", idx, len(dmsg), ln)
+		}
+		if err != nil {
+			t.Errorf("\nSum Write should never return an error, %d: got: %X", idx, err)
 		}
 
-		SumBig(dmsg, rbuf[:])
+		rest = dgst.Sum(rbuf[0:0])
 		hash, _ = hex.DecodeString(NistResult[idx])
 
 		if !nist.IsEqual(hash, rbuf[:]) {
-			t.Errorf("\nb) Sum %d:\n expected: %X\n      got: %X", idx, hash, rbuf[:])
+			t.Errorf("\nb) Sum %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
 		}
 	}
 }
 
+func runNistClose(t *testing.T, idx uint64) {
+	dgst := New()
+	extr := idx & 7
+	rest := [64]byte{}
+	dmsg := nist.Get(idx)
+
+	hash, _ := hex.DecodeString(NistResult[idx])
+
+	if extr == 0 {
+		dgst.Write(dmsg)
+		dgst.Close(rest[:], 0, 0)
+	} else {
+		dgst.Write(dmsg[:len(dmsg)-1])
+		dgst.Close(rest[:], dmsg[len(dmsg)-1], uint8(extr))
+	}
+
+	if !nist.IsEqual(hash, rest[:]) {
+		t.Errorf("\na) Close %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+	}
+
+	// Auto reset
+
+	if extr == 0 {
+		dgst.Write(dmsg)
+		dgst.Close(rest[:], 0, 0)
+	} else {
+		dgst.Write(dmsg[:len(dmsg)-1])
+		dgst.Close(rest[:], dmsg[len(dmsg)-1], uint8(extr))
+	}
+
+	if !nist.IsEqual(hash, rest[:]) {
+		t.Errorf("\nb) Close %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+	}
+}
+
+////////////////
+
 var NistResult = []string{
 	"5CD7436A91E27FC809D7015C3407540633DAB391127113CE6BA360F0C1E35F404510834A551610D6E871E75651EA381A8BA628AF1DCF2B2BE13AF2EB6247290F",
 	"CF85A498AB91E4C811F40BEC54DBE33777AB146AC800CFD449AC01F06C52429346AD199CC6AA52FB7BB0ADE5EE8EF445C623BD31EE6F4512D176E8AA8D238327",
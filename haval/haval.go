@@ -2,6 +2,7 @@ package haval
 
 import (
 	"encoding/hex"
+	"fmt"
 )
 
 const haval256Bits = int(32)
@@ -27,6 +28,8 @@ type Haval256 struct {
 	hashSize int
 	buffer   []byte
 	count    int
+
+	pad [blockSize + 10]byte
 }
 
 func New() *Haval256 {
@@ -51,7 +54,10 @@ func (ref *Haval256) padBuffer() []byte {
 	// pad out to 118 mod 128.  other 10 bytes have special use.
 	n := int(ref.count % blockSize)
 	padding := getInitialPadding(n)
-	result := make([]byte, padding+10)
+	result := ref.pad[:padding+10]
+	for i := range result {
+		result[i] = 0
+	}
 	result[0] = byte(0x01)
 
 	// save the version number (LSB 3), the number of rounds (3 bits in the
@@ -105,13 +111,27 @@ func (ref *Haval256) Update(b []byte, offset int, len int) {
 }
 
 func (ref *Haval256) Digest() []byte {
+	result := make([]byte, ref.hashSize)
+	ref.Close(result)
+
+	return result
+}
+
+// Close finishes the hash and stores the result in dst without
+// allocating, then resets this instance for future re-use. A call
+// to Close with a dst that is smaller then 32 bytes will return an error.
+func (ref *Haval256) Close(dst []byte) error {
+	if ln := len(dst); ref.hashSize > ln {
+		return fmt.Errorf("Haval Close: dst min length: %d, got %d", ref.hashSize, ln)
+	}
+
 	var tail = ref.padBuffer()     // pad remaining bytes in buffer
 	ref.Update(tail, 0, len(tail)) // last transform of a message
-	result := ref.getResult()      // make a result out of context
+	ref.getResult(dst)             // make a result out of context
 
 	ref.Reset() // reset this instance for future re-use
 
-	return result
+	return nil
 }
 
 func (ref *Haval256) Reset() { // reset this instance for future re-use
@@ -134,8 +154,7 @@ func (ref *Haval256) resetContext() {
 	ref.h7 = 0xEC4E6C89
 }
 
-func (ref *Haval256) getResult() []byte {
-	result := make([]byte, ref.hashSize)
+func (ref *Haval256) getResult(result []byte) {
 	result[31] = uint8(ref.h7 >> 24)
 	result[30] = uint8(ref.h7 >> 16)
 	result[29] = uint8(ref.h7 >> 8)
@@ -175,8 +194,6 @@ func (ref *Haval256) getResult() []byte {
 	result[2] = uint8(ref.h0 >> 16)
 	result[1] = uint8(ref.h0 >> 8)
 	result[0] = uint8(ref.h0)
-
-	return result
 }
 
 func (ref *Haval256) readInBytesTouint32(in []byte, i int) (uint32, int) {
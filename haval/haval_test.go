@@ -66,3 +66,32 @@ func Test_flipBits(t *testing.T) {
 		})
 	}
 }
+
+func TestClose(t *testing.T) {
+	dgst := New()
+	in := []byte("The quick brown fox jumps over the lazy dog")
+
+	if err := dgst.Close(make([]byte, 31)); err == nil {
+		t.Errorf("Close: expected an error on a short dst")
+	}
+
+	dgst.Update(in, 0, len(in))
+	exp := dgst.Digest()
+
+	res := make([]byte, 32)
+	dgst.Update(in, 0, len(in))
+	if err := dgst.Close(res); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+	if !bytes.Equal(exp, res) {
+		t.Errorf("\nExpected: %x \nGot: %x", exp, res)
+	}
+
+	allocs := testing.AllocsPerRun(16, func() {
+		dgst.Update(in, 0, len(in))
+		dgst.Close(res)
+	})
+	if allocs != 0 {
+		t.Errorf("Close: expected 0 allocations, got: %v", allocs)
+	}
+}
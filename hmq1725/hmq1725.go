@@ -0,0 +1,147 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package hmq1725 implements the HMQ1725 proof-of-work hash.
+package hmq1725
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/chain"
+	"github.com/rnichollx/go-x17/haval"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("hmq1725: dst shorter than HashSize")
+
+////////////////
+
+// Hash contains the state objects
+// required to perform the hmq1725.Hash.
+type Hash struct {
+	tha [64]byte
+	thb [64]byte
+
+	dgst  [chain.SHA512 + 1]stage
+	haval havalStage
+}
+
+// stage is the part of hash.Digest the chain needs.
+type stage interface {
+	Write(src []byte) (int, error)
+	Close(dst []byte, bits uint8, bcnt uint8) error
+}
+
+// New returns a new object to compute a hmq1725 hash.
+func New() *Hash {
+	ref := &Hash{}
+	for a := range ref.dgst {
+		ref.dgst[a] = chain.Algo(a).New()
+	}
+	ref.haval.ref = haval.New()
+
+	return ref
+}
+
+// Hash computes the hash from the src bytes and stores the result in dst,
+// errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the first
+// HashSize bytes of the last stage in dst, byte swapped like x17.Hash.
+// A call to Compute with a dst that is smaller then HashSize returns
+// ErrShortDst.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	var err error
+	step := func(dgst stage, in, out []byte) {
+		if err == nil {
+			dgst.Write(in)
+			err = dgst.Close(out, 0, 0)
+		}
+	}
+
+	ta := ref.tha[:]
+	tb := ref.thb[:]
+	d := &ref.dgst
+	hv := &ref.haval
+
+	step(d[chain.Blake], src, ta)
+	step(d[chain.BMW], ta, tb)
+	step(pick(tb, d[chain.Groestl], d[chain.Skein]), tb, ta)
+	step(d[chain.JH], ta, tb)
+	step(d[chain.Keccak], tb, ta)
+	step(pick(ta, d[chain.Blake], d[chain.BMW]), ta, tb)
+	step(d[chain.Luffa], tb, ta)
+	step(d[chain.CubeHash], ta, tb)
+	step(pick(tb, d[chain.Keccak], d[chain.JH]), tb, ta)
+	step(d[chain.Shavite], ta, tb)
+	step(d[chain.SIMD], tb, ta)
+	step(pick(ta, d[chain.Whirlpool], hv), ta, tb)
+	step(d[chain.Echo], tb, ta)
+	step(d[chain.Blake], ta, tb)
+	step(pick(tb, d[chain.Shavite], d[chain.Luffa]), tb, ta)
+	step(d[chain.Hamsi], ta, tb)
+	step(d[chain.Fugue], tb, ta)
+	step(pick(ta, d[chain.Echo], d[chain.SIMD]), ta, tb)
+	step(d[chain.Shabal], tb, ta)
+	step(d[chain.Whirlpool], ta, tb)
+	step(pick(tb, d[chain.Fugue], d[chain.SHA512]), tb, ta)
+	step(d[chain.Groestl], ta, tb)
+	step(d[chain.SHA512], tb, ta)
+	step(pick(ta, hv, d[chain.Whirlpool]), ta, tb)
+	step(d[chain.BMW], tb, ta)
+
+	if err != nil {
+		return fmt.Errorf("hmq1725: %w", err)
+	}
+
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ta[HashSize-1-i]
+	}
+	return nil
+}
+
+////////////////
+
+// pick returns a if bit 3 or 4 of the intermediate hash h is set, b otherwise.
+func pick(h []byte, a, b stage) stage {
+	if h[0]&24 != 0 {
+		return a
+	}
+	return b
+}
+
+// havalStage runs HAVAL-256/5 and zero extends its
+// output to the 64 bytes the next stage reads.
+type havalStage struct {
+	ref *haval.Haval256
+}
+
+func (hv *havalStage) Write(src []byte) (int, error) {
+	hv.ref.Update(src, 0, len(src))
+	return len(src), nil
+}
+
+func (hv *havalStage) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); 64 > ln {
+		return fmt.Errorf("Haval Close: dst min length: %d, got %d", 64, ln)
+	}
+	if err := hv.ref.Close(dst); err != nil {
+		return err
+	}
+
+	for i := 32; i < 64; i++ {
+		dst[i] = 0
+	}
+	return nil
+}
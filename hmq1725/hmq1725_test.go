@@ -0,0 +1,159 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package hmq1725
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/chain"
+	"github.com/rnichollx/go-x17/haval"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		hs.Hash([]byte(tsInfo[i].in), out[:])
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-hmq1725]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+// TestBranches checks the hash against a direct composition of fresh
+// digests, until both sides of all eight branches have been taken.
+func TestBranches(t *testing.T) {
+	hs := New()
+	seen := [8][2]bool{}
+
+	in := make([]byte, 80)
+	for n := uint64(0); n < 1024; n++ {
+		binary.LittleEndian.PutUint64(in[72:], n)
+
+		exp := reference(in, &seen)[:HashSize]
+		for l, r := 0, HashSize-1; l < r; l, r = l+1, r-1 {
+			exp[l], exp[r] = exp[r], exp[l]
+		}
+		res := [HashSize]byte{}
+		if err := hs.Compute(in, res[:]); err != nil {
+			t.Fatalf("[%d]: %v", n, err)
+		}
+		if !bytes.Equal(exp[:HashSize], res[:]) {
+			t.Fatalf("[%d]: invalid hash \nexpected:	%X, \ngot:		%X", n, exp[:HashSize], res)
+		}
+
+		done := true
+		for i := range seen {
+			done = done && seen[i][0] && seen[i][1]
+		}
+		if done {
+			return
+		}
+	}
+	t.Errorf("not all branches taken: %v", seen)
+}
+
+func TestShortDst(t *testing.T) {
+	hs := New()
+	out := make([]byte, HashSize-1)
+
+	if err := hs.Compute(nil, out); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+
+	hs.Hash(nil, out)
+	if !bytes.Equal(out, make([]byte, HashSize-1)) {
+		t.Errorf("Hash: expected dst to be left untouched, got: %X", out)
+	}
+}
+
+////////////////
+
+// reference computes HMQ1725 with fresh digests for every
+// stage and records which side of each branch was taken.
+func reference(in []byte, seen *[8][2]bool) []byte {
+	sum := func(a chain.Algo, h []byte) []byte {
+		dgst := a.New()
+		dgst.Write(h)
+		return dgst.Sum(nil)
+	}
+	hv := func(h []byte) []byte {
+		dgst := haval.New()
+		dgst.Update(h, 0, len(h))
+		return append(dgst.Digest(), make([]byte, 32)...)
+	}
+
+	br := 0
+	branch := func(h []byte, a, b func([]byte) []byte) []byte {
+		hit := h[0]&24 != 0
+		if hit {
+			seen[br][1] = true
+		} else {
+			seen[br][0] = true
+		}
+		br++
+
+		if hit {
+			return a(h)
+		}
+		return b(h)
+	}
+	algo := func(a chain.Algo) func([]byte) []byte {
+		return func(h []byte) []byte { return sum(a, h) }
+	}
+
+	h := sum(chain.Blake, in)
+	h = sum(chain.BMW, h)
+	h = branch(h, algo(chain.Groestl), algo(chain.Skein))
+	h = sum(chain.JH, h)
+	h = sum(chain.Keccak, h)
+	h = branch(h, algo(chain.Blake), algo(chain.BMW))
+	h = sum(chain.Luffa, h)
+	h = sum(chain.CubeHash, h)
+	h = branch(h, algo(chain.Keccak), algo(chain.JH))
+	h = sum(chain.Shavite, h)
+	h = sum(chain.SIMD, h)
+	h = branch(h, algo(chain.Whirlpool), hv)
+	h = sum(chain.Echo, h)
+	h = sum(chain.Blake, h)
+	h = branch(h, algo(chain.Shavite), algo(chain.Luffa))
+	h = sum(chain.Hamsi, h)
+	h = sum(chain.Fugue, h)
+	h = branch(h, algo(chain.Echo), algo(chain.SIMD))
+	h = sum(chain.Shabal, h)
+	h = sum(chain.Whirlpool, h)
+	h = branch(h, algo(chain.Fugue), algo(chain.SHA512))
+	h = sum(chain.Groestl, h)
+	h = sum(chain.SHA512, h)
+	h = branch(h, hv, algo(chain.Whirlpool))
+	return sum(chain.BMW, h)
+}
+
+// These come from this package and are in block explorer order, an
+// Espers header is needed before they confirm more than the past output.
+var tsInfo = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Empty",
+		"",
+		"a3a9b320f34ab98d61d9a60509725eccfc6efe092d0ab1ceb7d5dc8a52e8038c",
+	},
+	{
+		"HMQ1725",
+		"HMQ1725",
+		"4348c80f19b2e13d2204d0a490bddf426fac0955dd7269522a04fa321f60e7f1",
+	},
+	{
+		"Fox",
+		"The quick brown fox jumps over the lazy dog",
+		"bbf21d172aad69ec4fd2fb5efaba6eb6688bc613a69bcec839ce83800ab34519",
+	},
+}
@@ -15,11 +15,15 @@ const HashSize = int(64)
 // BlockSize holds the size of a block in bytes.
 const BlockSize = uintptr(72)
 
+// BlockSize256 holds the size of a KECCAK256 block in bytes.
+const BlockSize256 = uintptr(136)
+
 ////////////////
 
 type digest struct {
-	ptr uintptr
-	cnt uintptr
+	ptr  uintptr
+	cnt  uintptr
+	size int
 
 	h [25]uint64
 
@@ -29,6 +33,15 @@ type digest struct {
 // New returns a new digest compute a KECCAK512 hash.
 func New() hash.Digest {
 	ref := &digest{}
+	ref.size = 64
+	ref.Reset()
+	return ref
+}
+
+// New256 returns a new digest compute a KECCAK256 hash.
+func New256() hash.Digest {
+	ref := &digest{}
+	ref.size = 32
 	ref.Reset()
 	return ref
 }
@@ -38,7 +51,7 @@ func New() hash.Digest {
 // Reset resets the digest to its initial state.
 func (ref *digest) Reset() {
 	ref.ptr = 0
-	ref.cnt = 200 - (512 >> 2)
+	ref.cnt = 200 - uintptr(ref.size<<1)
 
 	h := ref.h[:]
 	h[0] = uint64(0x0)
@@ -74,7 +87,7 @@ func (ref *digest) Sum(dst []byte) []byte {
 	dgt := *ref
 	hsh := [64]byte{}
 	dgt.Close(hsh[:], 0, 0)
-	return append(dst, hsh[:]...)
+	return append(dst, hsh[:ref.size]...)
 }
 
 // Write more data to the running hash, never returns an error.
@@ -82,18 +95,19 @@ func (ref *digest) Write(src []byte) (int, error) {
 	sln := uintptr(len(src))
 	fln := len(src)
 	ptr := ref.ptr
+	bsz := ref.cnt
 
 	buf := ref.b[:]
 	sta := ref.h[:]
 
-	if sln < (BlockSize - ptr) {
+	if sln < (bsz - ptr) {
 		copy(ref.b[ptr:], src)
 		ref.ptr += sln
 		return int(sln), nil
 	}
 
 	for sln > 0 {
-		cln := BlockSize - ptr
+		cln := bsz - ptr
 
 		if cln > sln {
 			cln = sln
@@ -104,16 +118,10 @@ func (ref *digest) Write(src []byte) (int, error) {
 		src = src[cln:]
 		ptr += cln
 
-		if ptr == BlockSize {
-			sta[0] ^= decUInt64le(buf[0:])
-			sta[1] ^= decUInt64le(buf[8:])
-			sta[2] ^= decUInt64le(buf[16:])
-			sta[3] ^= decUInt64le(buf[24:])
-			sta[4] ^= decUInt64le(buf[32:])
-			sta[5] ^= decUInt64le(buf[40:])
-			sta[6] ^= decUInt64le(buf[48:])
-			sta[7] ^= decUInt64le(buf[56:])
-			sta[8] ^= decUInt64le(buf[64:])
+		if ptr == bsz {
+			for u := uintptr(0); u < bsz; u += 8 {
+				sta[u>>3] ^= decUInt64le(buf[u:])
+			}
 
 			for j := uintptr(0); j < 24; j++ {
 				var t0, t1, t2, t3, t4, tp uint64
@@ -357,28 +365,29 @@ func (ref *digest) Write(src []byte) (int, error) {
 
 // Close the digest by writing the last bits and storing the hash
 // in dst. This prepares the digest for reuse by calling reset. A call
-// to Close with a dst that is smaller then HashSize will return an error.
+// to Close with a dst that is smaller then Size will return an error.
 func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
-	if ln := len(dst); HashSize > ln {
-		return fmt.Errorf("Keccak Close: dst min length: %d, got %d", HashSize, ln)
+	if ln := len(dst); ref.size > ln {
+		return fmt.Errorf("Keccak Close: dst min length: %d, got %d", ref.size, ln)
 	}
 
 	var tln uintptr
-	var tmp [73]uint8
+	var tmp [BlockSize256 + 1]uint8
 
+	bsz := ref.cnt
 	off := uint8((uint16(0x100) | uint16(bits&0xFF)) >> (8 - bcnt))
 
-	if ref.ptr == (72 - 1) {
+	if ref.ptr == (bsz - 1) {
 		if bcnt == 7 {
 			tmp[0] = off
-			tmp[72] = 0x80
-			tln = 1 + 72
+			tmp[bsz] = 0x80
+			tln = 1 + bsz
 		} else {
 			tmp[0] = uint8(off | 0x80)
 			tln = 1
 		}
 	} else {
-		tln = 72 - ref.ptr
+		tln = bsz - ref.ptr
 		tmp[0] = off
 		tmp[tln-1] = 0x80
 	}
@@ -391,7 +400,7 @@ func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
 	ref.h[17] = ^ref.h[17]
 	ref.h[20] = ^ref.h[20]
 
-	for u := uintptr(0); u < 64; u += 8 {
+	for u := 0; u < ref.size; u += 8 {
 		encUInt64le(dst[u:], ref.h[(u>>3)])
 	}
 
@@ -400,13 +409,13 @@ func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
 }
 
 // Size returns the number of bytes required to store the hash.
-func (*digest) Size() int {
-	return HashSize
+func (ref *digest) Size() int {
+	return ref.size
 }
 
 // BlockSize returns the block size of the hash.
-func (*digest) BlockSize() int {
-	return int(BlockSize)
+func (ref *digest) BlockSize() int {
+	return int(ref.cnt)
 }
 
 ////////////////
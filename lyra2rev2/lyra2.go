@@ -0,0 +1,245 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package lyra2rev2
+
+import (
+	"encoding/binary"
+)
+
+// Lyra2 parameters used by Lyra2REv2, a single iteration over a
+// matrix of four rows by four columns.
+const (
+	kTimeCost = 1
+	kRows     = 4
+	kCols     = 4
+)
+
+// kBlockLen holds the number of words in a block of the sponge, and
+// kRowLen the number of words in a row of the matrix.
+const (
+	kBlockLen = 12
+	kRowLen   = kBlockLen * kCols
+)
+
+// kInputBlocks holds the number of 64 byte blocks that hold the
+// password, salt and basil padded with 10*1.
+const kInputBlocks = (HashSize+HashSize+6*8)/64 + 1
+
+////////////////
+
+// lyra2 holds the sponge state and the memory matrix.
+type lyra2 struct {
+	state  [16]uint64
+	matrix [kRows * kRowLen]uint64
+}
+
+// sum computes LYRA2(dst, pwd, salt) with the Lyra2REv2 parameters,
+// pwd and salt must both be HashSize bytes long.
+func (ref *lyra2) sum(dst, pwd, salt []byte) {
+	ref.absorbInput(pwd, salt)
+
+	ref.reducedSqueezeRow0(ref.row(0))
+	ref.reducedDuplexRow1(ref.row(0), ref.row(1))
+
+	// Setup phase.
+	row, prev, rowa := 2, 1, 0
+	step, window, gap := 1, 2, 1
+	for row < kRows {
+		ref.reducedDuplexRowSetup(ref.row(prev), ref.row(rowa), ref.row(row))
+
+		rowa = (rowa + step) & (window - 1)
+		prev = row
+		row++
+
+		if rowa == 0 {
+			step = window + gap
+			window *= 2
+			gap = -gap
+		}
+	}
+
+	// Wandering phase.
+	row = 0
+	for tau := 1; tau <= kTimeCost; tau++ {
+		step = kRows/2 - 1
+		if tau%2 == 0 {
+			step = -1
+		}
+		for {
+			rowa = int(ref.state[0] & (kRows - 1))
+			ref.reducedDuplexRow(ref.row(prev), ref.row(rowa), ref.row(row))
+
+			prev = row
+			row = (row + step) & (kRows - 1)
+			if row == 0 {
+				break
+			}
+		}
+	}
+
+	// Wrap-up phase.
+	ref.absorbBlock(ref.row(rowa)[:kBlockLen])
+	for i := 0; i < HashSize; i += 8 {
+		binary.LittleEndian.PutUint64(dst[i:], ref.state[i>>3])
+	}
+}
+
+// absorbInput resets the sponge and absorbs pwd || salt || basil
+// padded with 10*1, the basil holds the parameters as 64 bit words.
+func (ref *lyra2) absorbInput(pwd, salt []byte) {
+	var buf [kInputBlocks * 64]byte
+	copy(buf[:], pwd[:HashSize])
+	copy(buf[HashSize:], salt[:HashSize])
+
+	basil := buf[2*HashSize:]
+	binary.LittleEndian.PutUint64(basil[0:], uint64(HashSize))
+	binary.LittleEndian.PutUint64(basil[8:], uint64(HashSize))
+	binary.LittleEndian.PutUint64(basil[16:], uint64(HashSize))
+	binary.LittleEndian.PutUint64(basil[24:], kTimeCost)
+	binary.LittleEndian.PutUint64(basil[32:], kRows)
+	binary.LittleEndian.PutUint64(basil[40:], kCols)
+	buf[2*HashSize+6*8] = 0x80
+	buf[kInputBlocks*64-1] ^= 0x01
+
+	st := &ref.state
+	for i := 0; i < 8; i++ {
+		st[i] = 0
+	}
+	copy(st[8:], kBlake2bIV[:])
+
+	for i := 0; i < kInputBlocks; i++ {
+		for j := 0; j < 8; j++ {
+			st[j] ^= binary.LittleEndian.Uint64(buf[(i<<6)+(j<<3):])
+		}
+		rounds(st, 12)
+	}
+}
+
+func (ref *lyra2) row(i int) []uint64 {
+	return ref.matrix[i*kRowLen : (i+1)*kRowLen]
+}
+
+// absorbBlock xors a full block into the state and runs the
+// full permutation.
+func (ref *lyra2) absorbBlock(in []uint64) {
+	st := &ref.state
+	for j := 0; j < kBlockLen; j++ {
+		st[j] ^= in[j]
+	}
+	rounds(st, 12)
+}
+
+// reducedSqueezeRow0 fills row 0 with the state, last column first.
+func (ref *lyra2) reducedSqueezeRow0(out []uint64) {
+	st := &ref.state
+	for c := kCols - 1; c >= 0; c-- {
+		copy(out[c*kBlockLen:(c+1)*kBlockLen], st[:kBlockLen])
+		rounds(st, 1)
+	}
+}
+
+// reducedDuplexRow1 reads row 0 forwards and writes row 1 backwards.
+func (ref *lyra2) reducedDuplexRow1(in, out []uint64) {
+	st := &ref.state
+	for i := 0; i < kCols; i++ {
+		pin := in[i*kBlockLen:]
+		pout := out[(kCols-1-i)*kBlockLen:]
+
+		for j := 0; j < kBlockLen; j++ {
+			st[j] ^= pin[j]
+		}
+		rounds(st, 1)
+		for j := 0; j < kBlockLen; j++ {
+			pout[j] = pin[j] ^ st[j]
+		}
+	}
+}
+
+// reducedDuplexRowSetup writes row out backwards from the rows in and
+// inout, and xors the rotated state back into inout.
+func (ref *lyra2) reducedDuplexRowSetup(in, inout, out []uint64) {
+	st := &ref.state
+	for i := 0; i < kCols; i++ {
+		pin := in[i*kBlockLen:]
+		pio := inout[i*kBlockLen:]
+		pout := out[(kCols-1-i)*kBlockLen:]
+
+		for j := 0; j < kBlockLen; j++ {
+			st[j] ^= pin[j] + pio[j]
+		}
+		rounds(st, 1)
+		for j := 0; j < kBlockLen; j++ {
+			pout[j] = pin[j] ^ st[j]
+		}
+		xorRotW(pio, st)
+	}
+}
+
+// reducedDuplexRow updates the row out and inout from the rows in and
+// inout, inout and out may be the same row.
+func (ref *lyra2) reducedDuplexRow(in, inout, out []uint64) {
+	st := &ref.state
+	for i := 0; i < kCols; i++ {
+		pin := in[i*kBlockLen:]
+		pio := inout[i*kBlockLen:]
+		pout := out[i*kBlockLen:]
+
+		for j := 0; j < kBlockLen; j++ {
+			st[j] ^= pin[j] + pio[j]
+		}
+		rounds(st, 1)
+		for j := 0; j < kBlockLen; j++ {
+			pout[j] ^= st[j]
+		}
+		xorRotW(pio, st)
+	}
+}
+
+// xorRotW xors the first block of the state, rotated by one word,
+// into dst.
+func xorRotW(dst []uint64, st *[16]uint64) {
+	dst[0] ^= st[kBlockLen-1]
+	for j := 1; j < kBlockLen; j++ {
+		dst[j] ^= st[j-1]
+	}
+}
+
+// rounds runs the given number of BLAKE2b rounds over the state,
+// without message words.
+func rounds(v *[16]uint64, n int) {
+	for r := 0; r < n; r++ {
+		g(v, 0, 4, 8, 12)
+		g(v, 1, 5, 9, 13)
+		g(v, 2, 6, 10, 14)
+		g(v, 3, 7, 11, 15)
+		g(v, 0, 5, 10, 15)
+		g(v, 1, 6, 11, 12)
+		g(v, 2, 7, 8, 13)
+		g(v, 3, 4, 9, 14)
+	}
+}
+
+func g(v *[16]uint64, a, b, c, d int) {
+	v[a] += v[b]
+	v[d] = rotr(v[d]^v[a], 32)
+	v[c] += v[d]
+	v[b] = rotr(v[b]^v[c], 24)
+	v[a] += v[b]
+	v[d] = rotr(v[d]^v[a], 16)
+	v[c] += v[d]
+	v[b] = rotr(v[b]^v[c], 63)
+}
+
+func rotr(x uint64, n uint) uint64 {
+	return (x >> n) | (x << (64 - n))
+}
+
+////////////////
+
+var kBlake2bIV = [8]uint64{
+	uint64(0x6A09E667F3BCC908), uint64(0xBB67AE8584CAA73B),
+	uint64(0x3C6EF372FE94F82B), uint64(0xA54FF53A5F1D36F1),
+	uint64(0x510E527FADE682D1), uint64(0x9B05688C2B3E6C1F),
+	uint64(0x1F83D9ABFB41BD6B), uint64(0x5BE0CD19137E2179),
+}
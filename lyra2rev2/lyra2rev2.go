@@ -0,0 +1,108 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package lyra2rev2 implements the Lyra2REv2 proof-of-work hash of
+// Vertcoin and Verge, a chain of 256 bit digests around the Lyra2
+// memory-hard sponge.
+package lyra2rev2
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/blake"
+	"github.com/rnichollx/go-x17/bmw"
+	"github.com/rnichollx/go-x17/cubed"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/keccak"
+	"github.com/rnichollx/go-x17/skein"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("lyra2rev2: dst shorter than HashSize")
+
+////////////////
+
+// Hash contains the state objects required
+// to perform a Lyra2REv2 hash.
+type Hash struct {
+	tha [HashSize]byte
+	thb [HashSize]byte
+
+	blake    hash.Digest
+	keccak   hash.Digest
+	cubehash hash.Digest
+	skein    hash.Digest
+	bmw      hash.Digest
+
+	lyra2 lyra2
+}
+
+// New returns a new object to compute a Lyra2REv2 hash.
+func New() *Hash {
+	return &Hash{
+		blake:    blake.New256(),
+		keccak:   keccak.New256(),
+		cubehash: cubed.New256(),
+		skein:    skein.New256(),
+		bmw:      bmw.New256(),
+	}
+}
+
+// Hash computes the hash from the src bytes and stores the result in dst,
+// errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the result in
+// dst, byte swapped like x17.Hash so it reads as block explorers show it.
+// A call to Compute with a dst that is smaller then HashSize returns
+// ErrShortDst.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	ta := ref.tha[:]
+	tb := ref.thb[:]
+
+	if err := ref.stage("blake", ref.blake, src, ta); err != nil {
+		return err
+	}
+	if err := ref.stage("keccak", ref.keccak, ta, tb); err != nil {
+		return err
+	}
+	if err := ref.stage("cubehash", ref.cubehash, tb, ta); err != nil {
+		return err
+	}
+	ref.lyra2.sum(tb, ta, ta)
+	if err := ref.stage("skein", ref.skein, tb, ta); err != nil {
+		return err
+	}
+	if err := ref.stage("cubehash", ref.cubehash, ta, tb); err != nil {
+		return err
+	}
+	if err := ref.stage("bmw", ref.bmw, tb, ta); err != nil {
+		return err
+	}
+
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ta[HashSize-1-i]
+	}
+	return nil
+}
+
+////////////////
+
+func (ref *Hash) stage(name string, dgst hash.Digest, src, dst []byte) error {
+	dgst.Reset()
+	dgst.Write(src)
+	if err := dgst.Close(dst, 0, 0); err != nil {
+		return fmt.Errorf("lyra2rev2: %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package lyra2rev2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/blake"
+	"github.com/rnichollx/go-x17/bmw"
+	"github.com/rnichollx/go-x17/cubed"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/keccak"
+	"github.com/rnichollx/go-x17/skein"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		in, _ := hex.DecodeString(tsInfo[i].in)
+		hs.Hash(in, out[:])
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-lyra2rev2]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	hs := New()
+
+	sum := func(dgst hash.Digest, src []byte) []byte {
+		dgst.Write(src)
+		return dgst.Sum(nil)
+	}
+
+	for i := range tsInfo {
+		in, _ := hex.DecodeString(tsInfo[i].in)
+
+		a := sum(blake.New256(), in)
+		a = sum(keccak.New256(), a)
+		a = sum(cubed.New256(), a)
+		b := make([]byte, HashSize)
+		(&lyra2{}).sum(b, a, a)
+		b = sum(skein.New256(), b)
+		b = sum(cubed.New256(), b)
+		b = sum(bmw.New256(), b)
+
+		exp := make([]byte, HashSize)
+		for j := range exp {
+			exp[j] = b[HashSize-1-j]
+		}
+
+		res := [HashSize]byte{}
+		if err := hs.Compute(in, res[:]); err != nil {
+			t.Fatalf("[%s-lyra2rev2]: %v", tsInfo[i].id, err)
+		}
+		if !bytes.Equal(exp, res[:]) {
+			t.Errorf("[%s-lyra2rev2]: invalid hash \nexpected:	%X, \ngot:		%X", tsInfo[i].id, exp, res)
+		}
+	}
+}
+
+func TestLyra2(t *testing.T) {
+	pwd := make([]byte, HashSize)
+	for i := range pwd {
+		pwd[i] = byte(i)
+	}
+
+	var a, b [HashSize]byte
+	ref := &lyra2{}
+	ref.sum(a[:], pwd, pwd)
+	ref.sum(b[:], pwd, pwd)
+	if a != b {
+		t.Errorf("sum is not repeatable:\n first: %X\nsecond: %X", a, b)
+	}
+
+	// A fresh sponge must give the same result as a used one,
+	// every word of the matrix is written before it is read.
+	(&lyra2{}).sum(b[:], pwd, pwd)
+	if a != b {
+		t.Errorf("sum depends on the previous matrix:\n  used: %X\n fresh: %X", a, b)
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	hs := New()
+	out := [HashSize]byte{}
+
+	allocs := testing.AllocsPerRun(8, func() {
+		hs.Hash(kHeader, out[:])
+	})
+	if allocs != 0 {
+		t.Errorf("Hash: expected 0 allocations, got: %v", allocs)
+	}
+}
+
+func TestShortDst(t *testing.T) {
+	if err := New().Compute(nil, make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+}
+
+////////////////
+
+// kHeader is an 80 byte block header.
+var kHeader = make([]byte, 80)
+
+// The vector of the reference Go Lyra2REv2 (bitgoin/lyra2rev2), an
+// 80 byte header of "test" followed by zeros. The reference prints the
+// raw digest 5f21d776...851a4abf, Compute swaps it like x17.Hash.
+var tsInfo = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Reference",
+		hex.EncodeToString(append([]byte("test"), make([]byte, 76)...)),
+		"bf4a1a8515de0649b2a61b412957766804c5dd93c97ddb87fce81a3b76d7215f",
+	},
+}
@@ -0,0 +1,38 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package polytimos implements the Polytimos proof-of-work hash.
+package polytimos
+
+import (
+	"errors"
+
+	"github.com/rnichollx/go-x17/chain"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = chain.HashSize
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("polytimos: dst shorter than HashSize")
+
+// Stages returns the stages of the Polytimos hash.
+func Stages() []chain.Algo {
+	return append([]chain.Algo(nil), stages...)
+}
+
+var stages = []chain.Algo{
+	chain.Skein, chain.Shabal, chain.Echo, chain.Luffa, chain.Fugue, chain.GOST,
+}
+
+////////////////
+
+// Hash contains the state objects required to perform the polytimos.Hash,
+// its Compute stores the first HashSize bytes of the GOST512 digest in
+// dst, byte swapped like x17.Hash.
+type Hash = chain.Pow
+
+// New returns a new object to compute a polytimos hash.
+func New() *Hash {
+	return chain.NewPow(ErrShortDst, stages...)
+}
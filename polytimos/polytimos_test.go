@@ -0,0 +1,89 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package polytimos
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rnichollx/go-x17/echo"
+	"github.com/rnichollx/go-x17/fugue"
+	"github.com/rnichollx/go-x17/gost"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/luffa"
+	"github.com/rnichollx/go-x17/shabal"
+	"github.com/rnichollx/go-x17/skein"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		hs.Hash([]byte(tsInfo[i].in), out[:])
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-polytimos]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	hs := New()
+
+	for i := range tsInfo {
+		exp := []byte(tsInfo[i].in)
+		for _, dgst := range []hash.Digest{skein.New(), shabal.New(), echo.New(), luffa.New(), fugue.New(), gost.New512LE()} {
+			dgst.Write(exp)
+			exp = dgst.Sum(nil)
+		}
+
+		res := [HashSize]byte{}
+		if err := hs.Compute([]byte(tsInfo[i].in), res[:]); err != nil {
+			t.Fatalf("[%s]: %v", tsInfo[i].id, err)
+		}
+		for l, r := 0, HashSize-1; l < r; l, r = l+1, r-1 {
+			exp[l], exp[r] = exp[r], exp[l]
+		}
+		if !bytes.Equal(exp[:HashSize], res[:]) {
+			t.Errorf("[%s-polytimos]: invalid hash \nexpected:	%X, \ngot:		%X", tsInfo[i].id, exp[:HashSize], res)
+		}
+	}
+}
+
+func TestShortDst(t *testing.T) {
+	if err := New().Compute(nil, make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+	if msg := ErrShortDst.Error(); !strings.HasPrefix(msg, "polytimos: ") {
+		t.Errorf("ErrShortDst: expected a polytimos prefix, got: %s", msg)
+	}
+}
+
+////////////////
+
+// Regression vectors, shown as explorers show hashes. They come from this
+// package, a Polytimos block header is still needed to confirm them.
+var tsInfo = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Empty",
+		"",
+		"7675f058162cf4dce52eb7cec655634523b80c7b41a75de0c664660d2efdf139",
+	},
+	{
+		"Polytimos",
+		"polytimos",
+		"c695fb82082a197c95b65318c875706aa14320f4f7dcfe7124e3570c0cec0b2a",
+	},
+	{
+		"Fox",
+		"The quick brown fox jumps over the lazy dog",
+		"28b241c0df5f09b5b78ccf35eeac95f9e0cad90b3b91e5ed09d0886b4310914a",
+	},
+}
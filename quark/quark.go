@@ -0,0 +1,110 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package quark implements the Quark proof-of-work hash.
+package quark
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/blake"
+	"github.com/rnichollx/go-x17/bmw"
+	"github.com/rnichollx/go-x17/groest"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/jhash"
+	"github.com/rnichollx/go-x17/keccak"
+	"github.com/rnichollx/go-x17/skein"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("quark: dst shorter than HashSize")
+
+////////////////
+
+// Hash contains the state objects
+// required to perform the quark.Hash.
+type Hash struct {
+	tha [64]byte
+	thb [64]byte
+
+	blake  hash.Digest
+	bmw    hash.Digest
+	groest hash.Digest
+	jhash  hash.Digest
+	keccak hash.Digest
+	skein  hash.Digest
+}
+
+// New returns a new object to compute a quark hash.
+func New() *Hash {
+	ref := &Hash{}
+	ref.blake = blake.New()
+	ref.bmw = bmw.New()
+	ref.groest = groest.New()
+	ref.jhash = jhash.New()
+	ref.keccak = keccak.New()
+	ref.skein = skein.New()
+
+	return ref
+}
+
+// Hash computes the hash from the src bytes and stores the result in dst,
+// errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the first
+// HashSize bytes of the last stage in dst, byte swapped like x17.Hash.
+// A call to Compute with a dst that is smaller then HashSize returns
+// ErrShortDst.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	var err error
+	step := func(dgst hash.Digest, in, out []byte) {
+		if err == nil {
+			dgst.Write(in)
+			err = dgst.Close(out, 0, 0)
+		}
+	}
+
+	ta := ref.tha[:]
+	tb := ref.thb[:]
+
+	ref.blake.Reset()
+	step(ref.blake, src, ta)
+	step(ref.bmw, ta, tb)
+	step(pick(tb, ref.groest, ref.skein), tb, ta)
+	step(ref.groest, ta, tb)
+	step(ref.jhash, tb, ta)
+	step(pick(ta, ref.blake, ref.bmw), ta, tb)
+	step(ref.keccak, tb, ta)
+	step(ref.skein, ta, tb)
+	step(pick(tb, ref.keccak, ref.jhash), tb, ta)
+
+	if err != nil {
+		return fmt.Errorf("quark: %w", err)
+	}
+
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ta[HashSize-1-i]
+	}
+	return nil
+}
+
+////////////////
+
+// pick returns a if bit 3 of the intermediate hash h is set, b otherwise.
+func pick(h []byte, a, b hash.Digest) hash.Digest {
+	if h[0]&8 != 0 {
+		return a
+	}
+	return b
+}
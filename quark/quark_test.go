@@ -0,0 +1,138 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package quark
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/blake"
+	"github.com/rnichollx/go-x17/bmw"
+	"github.com/rnichollx/go-x17/groest"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/jhash"
+	"github.com/rnichollx/go-x17/keccak"
+	"github.com/rnichollx/go-x17/skein"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	for _, ts := range tsInfo {
+		out := [HashSize]byte{}
+		if err := hs.Compute(ts.in, out[:]); err != nil {
+			t.Fatalf("[%s]: %v", ts.id, err)
+		}
+		if res := hex.EncodeToString(out[:]); res != ts.out {
+			t.Errorf("[%s]: invalid hash \nexpected:	%s, \ngot:		%s", ts.id, ts.out, res)
+		}
+	}
+}
+
+// TestBranches checks every branch against a direct composition
+// of fresh digests, until all six sides have been taken.
+func TestBranches(t *testing.T) {
+	hs := New()
+	seen := [3][2]bool{}
+
+	sum := func(dgst hash.Digest, in []byte) []byte {
+		dgst.Write(in)
+		return dgst.Sum(nil)
+	}
+
+	in := make([]byte, 8)
+	for n := uint64(0); n < 256; n++ {
+		binary.LittleEndian.PutUint64(in, n)
+
+		h := sum(bmw.New(), sum(blake.New(), in))
+		seen[0][h[0]>>3&1] = true
+		if h[0]&8 != 0 {
+			h = sum(groest.New(), h)
+		} else {
+			h = sum(skein.New(), h)
+		}
+		h = sum(jhash.New(), sum(groest.New(), h))
+		seen[1][h[0]>>3&1] = true
+		if h[0]&8 != 0 {
+			h = sum(blake.New(), h)
+		} else {
+			h = sum(bmw.New(), h)
+		}
+		h = sum(skein.New(), sum(keccak.New(), h))
+		seen[2][h[0]>>3&1] = true
+		if h[0]&8 != 0 {
+			h = sum(keccak.New(), h)
+		} else {
+			h = sum(jhash.New(), h)
+		}
+
+		out := [HashSize]byte{}
+		hs.Hash(in, out[:])
+		if !bytes.Equal(reverse(h[:HashSize]), out[:]) {
+			t.Fatalf("[%d]: invalid hash \nexpected:	%X, \ngot:		%X", n, reverse(h[:HashSize]), out)
+		}
+
+		if seen == [3][2]bool{{true, true}, {true, true}, {true, true}} {
+			return
+		}
+	}
+	t.Errorf("not all branches taken: %v", seen)
+}
+
+func TestShortDst(t *testing.T) {
+	hs := New()
+	out := make([]byte, HashSize-1)
+
+	if err := hs.Compute(nil, out); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+
+	hs.Hash(nil, out)
+	if !bytes.Equal(out, make([]byte, HashSize-1)) {
+		t.Errorf("Hash: expected dst to be left untouched, got: %X", out)
+	}
+}
+
+////////////////
+
+func reverse(src []byte) []byte {
+	res := make([]byte, len(src))
+	for i := range src {
+		res[len(src)-1-i] = src[i]
+	}
+	return res
+}
+
+func header(version uint32, prev, merkle string, time, bits, nonce uint32) []byte {
+	res := make([]byte, 80)
+	binary.LittleEndian.PutUint32(res[0:], version)
+	ph, _ := hex.DecodeString(prev)
+	copy(res[4:36], reverse(ph))
+	mh, _ := hex.DecodeString(merkle)
+	copy(res[36:68], reverse(mh))
+	binary.LittleEndian.PutUint32(res[68:], time)
+	binary.LittleEndian.PutUint32(res[72:], bits)
+	binary.LittleEndian.PutUint32(res[76:], nonce)
+	return res
+}
+
+// Hashes read as block explorers show them, like the output of Compute.
+var tsInfo = []struct {
+	id  string
+	in  []byte
+	out string
+}{
+	{
+		"PIVX genesis",
+		header(
+			1,
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"1b2ef6e2f28be914103a277377ae7729dcd125dfeb8bf97bd5964ba72b6dc39b",
+			1454124731, 0x1e0ffff0, 2402015,
+		),
+		"0000041e482b9b9691d98eefb48473405c0b8ec31b76df3797c74a78680ef818",
+	},
+}
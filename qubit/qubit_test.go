@@ -0,0 +1,88 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package qubit
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rnichollx/go-x17/cubed"
+	"github.com/rnichollx/go-x17/echo"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/luffa"
+	"github.com/rnichollx/go-x17/shavite"
+	"github.com/rnichollx/go-x17/simd"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		hs.Hash([]byte(tsInfo[i].in), out[:])
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-qubit]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	hs := New()
+
+	for i := range tsInfo {
+		exp := []byte(tsInfo[i].in)
+		for _, dgst := range []hash.Digest{luffa.New(), cubed.New(), shavite.New(), simd.New(), echo.New()} {
+			dgst.Write(exp)
+			exp = dgst.Sum(nil)
+		}
+
+		res := [HashSize]byte{}
+		if err := hs.Compute([]byte(tsInfo[i].in), res[:]); err != nil {
+			t.Fatalf("[%s]: %v", tsInfo[i].id, err)
+		}
+		for l, r := 0, HashSize-1; l < r; l, r = l+1, r-1 {
+			exp[l], exp[r] = exp[r], exp[l]
+		}
+		if !bytes.Equal(exp[:HashSize], res[:]) {
+			t.Errorf("[%s-qubit]: invalid hash \nexpected:	%X, \ngot:		%X", tsInfo[i].id, exp[:HashSize], res)
+		}
+	}
+}
+
+func TestShortDst(t *testing.T) {
+	if err := New().Compute(nil, make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+	if msg := ErrShortDst.Error(); !strings.HasPrefix(msg, "qubit: ") {
+		t.Errorf("ErrShortDst: expected a qubit prefix, got: %s", msg)
+	}
+}
+
+////////////////
+
+// Regression vectors in block explorer order, a DigiByte Qubit block
+// should replace them once one can be checked.
+var tsInfo = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Empty",
+		"",
+		"05c5d88ec530c5df6158c88e372d6e08f3ff9d57455d29977769fb767113f0ba",
+	},
+	{
+		"DGB",
+		"DGB",
+		"f6043b34b4fa4c5fddd6df0d0b4296ac13ceb91d1b5b99e8fb0af19f5ab83f41",
+	},
+	{
+		"Fox",
+		"The quick brown fox jumps over the lazy dog",
+		"74c8e3c6d7c69ccee38a11e77c7c22747b31bcfb1bd9b9a9298650d98b156777",
+	},
+}
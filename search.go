@@ -0,0 +1,123 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// HeaderSize holds the size of a block header in bytes.
+const HeaderSize = int(80)
+
+// NonceOffset holds the offset of the little endian nonce in a header.
+const NonceOffset = int(76)
+
+// searchChunk holds the number of nonces a worker claims at once.
+const searchChunk = 1024
+
+////////////////
+
+// Match is a nonce whose header hash is at or below the search target.
+type Match struct {
+	Nonce uint32
+	Hash  [HashSize]byte
+}
+
+// Stats holds the throughput of a Search.
+type Stats struct {
+	Hashes  uint64
+	Elapsed time.Duration
+}
+
+// HashRate returns the number of hashes computed per second.
+func (st Stats) HashRate() float64 {
+	if st.Elapsed <= 0 {
+		return 0
+	}
+	return float64(st.Hashes) / st.Elapsed.Seconds()
+}
+
+// Search hashes the 80 byte header with every nonce from first to last,
+// inclusive, and returns all matches ordered by nonce. A hash matches
+// when, read as the big endian number produced by Hash, it is at or below
+// target. The header is not modified. A workers value below one uses
+// GOMAXPROCS workers. When ctx is cancelled the matches found so far
+// are returned together with ctx.Err().
+func Search(ctx context.Context, header []byte, first, last uint32, target [HashSize]byte, workers int) ([]Match, Stats, error) {
+	if ln := len(header); HeaderSize != ln {
+		return nil, Stats{}, fmt.Errorf("Search: header length: %d, got %d", HeaderSize, ln)
+	}
+	if first > last {
+		return nil, Stats{}, fmt.Errorf("Search: invalid nonce range: %d > %d", first, last)
+	}
+
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	total := uint64(last) - uint64(first) + 1
+	if lim := (total + searchChunk - 1) / searchChunk; uint64(workers) > lim {
+		workers = int(lim)
+	}
+
+	var next, hashes uint64
+	var mtx sync.Mutex
+	var wg sync.WaitGroup
+	var res []Match
+
+	start := time.Now()
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ref := pool.Get().(*Hash)
+			defer pool.Put(ref)
+
+			hdr := [HeaderSize]byte{}
+			out := [HashSize]byte{}
+			copy(hdr[:], header)
+
+			for ctx.Err() == nil {
+				end := atomic.AddUint64(&next, searchChunk)
+				beg := end - searchChunk
+				if beg >= total {
+					return
+				}
+				if end > total {
+					end = total
+				}
+
+				for i := beg; i < end; i++ {
+					nonce := first + uint32(i)
+					binary.LittleEndian.PutUint32(hdr[NonceOffset:], nonce)
+					ref.Hash(hdr[:], out[:])
+
+					if bytes.Compare(out[:], target[:]) <= 0 {
+						mtx.Lock()
+						res = append(res, Match{Nonce: nonce, Hash: out})
+						mtx.Unlock()
+					}
+				}
+				atomic.AddUint64(&hashes, end-beg)
+			}
+		}()
+	}
+
+	wg.Wait()
+	st := Stats{Hashes: hashes, Elapsed: time.Since(start)}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Nonce < res[j].Nonce
+	})
+	return res, st, ctx.Err()
+}
@@ -0,0 +1,83 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	nonce := binary.LittleEndian.Uint32(hexBlockVerge[NonceOffset:])
+	header := make([]byte, HeaderSize)
+	copy(header, hexBlockVerge)
+	binary.LittleEndian.PutUint32(header[NonceOffset:], 0)
+
+	target := [HashSize]byte{}
+	New().Hash(hexBlockVerge, target[:])
+
+	res, st, err := Search(context.Background(), header, nonce-700, nonce+300, target, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res) != 1 || res[0].Nonce != nonce || res[0].Hash != target {
+		t.Errorf("expected nonce %d with hash %X, got: %v", nonce, target, res)
+	}
+	if st.Hashes != 1001 {
+		t.Errorf("Hashes: expected: %d, got: %d", 1001, st.Hashes)
+	}
+	if st.HashRate() <= 0 {
+		t.Errorf("HashRate: expected a positive rate, got: %f", st.HashRate())
+	}
+	if binary.LittleEndian.Uint32(header[NonceOffset:]) != 0 {
+		t.Error("header template was modified")
+	}
+}
+
+func TestSearchAll(t *testing.T) {
+	target := [HashSize]byte{}
+	for i := range target {
+		target[i] = 0xFF
+	}
+
+	res, _, err := Search(context.Background(), hexBlockVerge, 10, 20, target, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(res) != 11 {
+		t.Fatalf("expected %d matches, got: %d", 11, len(res))
+	}
+
+	hs := New()
+	hdr := make([]byte, HeaderSize)
+	copy(hdr, hexBlockVerge)
+	for i := range res {
+		out := [HashSize]byte{}
+		binary.LittleEndian.PutUint32(hdr[NonceOffset:], uint32(10+i))
+		hs.Hash(hdr, out[:])
+
+		if res[i].Nonce != uint32(10+i) || !bytes.Equal(res[i].Hash[:], out[:]) {
+			t.Errorf("match %d: expected nonce %d with hash %X, got: %d %X", i, 10+i, out, res[i].Nonce, res[i].Hash)
+		}
+	}
+}
+
+func TestSearchErrors(t *testing.T) {
+	target := [HashSize]byte{}
+
+	if _, _, err := Search(context.Background(), hexBlockVerge[:79], 0, 1, target, 1); err == nil {
+		t.Error("expected header length error, got: nil")
+	}
+	if _, _, err := Search(context.Background(), hexBlockVerge, 2, 1, target, 1); err == nil {
+		t.Error("expected nonce range error, got: nil")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := Search(ctx, hexBlockVerge, 0, 0xFFFFFFFF, target, 2); err != context.Canceled {
+		t.Errorf("expected: %v, got: %v", context.Canceled, err)
+	}
+}
@@ -1,20 +1,19 @@
-#include "gshabal.h"
-
-#include <stdlib.h>
-#include <stdint.h>
-#include <string.h>
-#include <stdio.h>
+//go:build cgo
+// +build cgo
 
-#include "sph_shabal.h"
+#include "gshabal.h"
 
-void HashShabal(const char *input, int inputLen, char *output)
+void ShabalInit(sph_shabal512_context *ctx)
 {
-    sph_shabal512_context ctx_fugue;
-    char hash[64];
+    sph_shabal512_init(ctx);
+}
 
-    sph_shabal512_init(&ctx_fugue);
-    sph_shabal512(&ctx_fugue, input, inputLen);
-    sph_shabal512_close(&ctx_fugue, hash);
+void ShabalWrite(sph_shabal512_context *ctx, const void *input, size_t inputLen)
+{
+    sph_shabal512(ctx, input, inputLen);
+}
 
-    memcpy(output, hash, 64);
+void ShabalClose(sph_shabal512_context *ctx, unsigned bits, unsigned bcnt, void *output)
+{
+    sph_shabal512_addbits_and_close(ctx, bits, bcnt, output);
 }
@@ -1,14 +1,20 @@
-#ifndef VERGE_CRYPTO_POW_HAMSI_H
-#define VERGE_CRYPTO_POW_HAMSI_H
+#ifndef VERGE_CRYPTO_POW_GSHABAL_H
+#define VERGE_CRYPTO_POW_GSHABAL_H
+
+#include <stddef.h>
+
+#include "sph_shabal.h"
 
 #ifdef __cplusplus
 extern "C" {
 #endif
 
-void HashShabal(const char *input, int inputLen, char *output);
+void ShabalInit(sph_shabal512_context *ctx);
+void ShabalWrite(sph_shabal512_context *ctx, const void *input, size_t inputLen);
+void ShabalClose(sph_shabal512_context *ctx, unsigned bits, unsigned bcnt, void *output);
 
 #ifdef __cplusplus
 }
 #endif
 
-#endif // VERGE_CRYPTO_POW_HAMSI_H
+#endif // VERGE_CRYPTO_POW_GSHABAL_H
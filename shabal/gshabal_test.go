@@ -0,0 +1,94 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+//go:build cgo
+// +build cgo
+
+package shabal
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/nist"
+)
+
+func TestCgoApi(t *testing.T) {
+	dgst := NewCgo()
+	if sz := dgst.Size(); HashSize != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	if sz := dgst.BlockSize(); int(BlockSize) != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	res := [2]byte{}
+	if nil == dgst.Close(res[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
+func TestSumBig(t *testing.T) {
+	out := make([]byte, 64)
+
+	for i := range tsInfo {
+		length := len(tsInfo[i].out)
+		destination := make([]byte, length)
+
+		SumBig(tsInfo[i].in[:], out[:])
+		hex.Encode(destination, out[:])
+
+		if !bytes.Equal(destination[:], tsInfo[i].out[:]) {
+			t.Errorf("%s: invalid hash expected: %s, got: %s", tsInfo[i].id, tsInfo[i].out[:], destination[:])
+		}
+	}
+}
+
+func TestCgoCloseBits(t *testing.T) {
+	for i := range tsBits {
+		dgst := NewCgo()
+		rest := [64]byte{}
+
+		dgst.Write(tsBits[i].in)
+		dgst.Close(rest[:], tsBits[i].bits, tsBits[i].bcnt)
+		hash, _ := hex.DecodeString(tsBits[i].out)
+
+		if !nist.IsEqual(hash, rest[:]) {
+			t.Errorf("\nClose bits %d:\n expected: %X\n      got: %X", i, hash, rest[:])
+		}
+	}
+}
+
+func TestCgoNist(t *testing.T) {
+	gdgst := New()
+	cdgst := NewCgo()
+
+	for i := uint64(0); i < 2048; i++ {
+		extr := i & 7
+		dmsg := nist.Get(i)
+		gbuf := [64]byte{}
+		cbuf := [64]byte{}
+
+		if extr == 0 {
+			gdgst.Write(dmsg)
+			cdgst.Write(dmsg)
+
+			hash, _ := hex.DecodeString(NistResult[i])
+			if rest := cdgst.Sum(nil); !nist.IsEqual(hash, rest) {
+				t.Errorf("\nSum %d:\n expected: %X\n      got: %X", i, hash, rest)
+			}
+
+			gdgst.Close(gbuf[:], 0, 0)
+			cdgst.Close(cbuf[:], 0, 0)
+		} else {
+			gdgst.Write(dmsg[:len(dmsg)-1])
+			cdgst.Write(dmsg[:len(dmsg)-1])
+			gdgst.Close(gbuf[:], dmsg[len(dmsg)-1], uint8(extr))
+			cdgst.Close(cbuf[:], dmsg[len(dmsg)-1], uint8(extr))
+		}
+
+		if !nist.IsEqual(gbuf[:], cbuf[:]) {
+			t.Errorf("\nClose %d:\n expected: %X\n      got: %X", i, cbuf[:], gbuf[:])
+		}
+	}
+}
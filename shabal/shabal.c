@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 /* $Id: shabal.c 175 2010-05-07 16:03:20Z tp $ */
 /*
  * Shabal implementation.
@@ -3,15 +3,225 @@
 
 package shabal
 
-// #include "gshabal.h"
-import "C"
+import (
+	"fmt"
 
-// SumBig creates a hamsi hash of the given bytes and returns always exactly 64 bytes.
-func SumBig(inputData []byte, dst []byte) {
-	var hashOutput [64]C.char
+	"github.com/rnichollx/go-x17/hash"
+)
 
-	C.HashShabal(C.CString(string(inputData)), C.int(len(inputData)), &hashOutput[0])
-	outputBuffer := []byte(C.GoStringN(&hashOutput[0], 64))
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(64)
 
-	copy(dst[:], outputBuffer)
+// BlockSize holds the size of a block in bytes.
+const BlockSize = uintptr(64)
+
+////////////////
+
+type digest struct {
+	ptr uintptr
+	cnt uint64
+
+	a [12]uint32
+	b [16]uint32
+	c [16]uint32
+
+	x [BlockSize]byte
+}
+
+// New returns a new digest to compute a SHABAL512 hash.
+func New() hash.Digest {
+	ref := &digest{}
+	ref.Reset()
+	return ref
+}
+
+////////////////
+
+// Reset resets the digest to its initial state.
+func (ref *digest) Reset() {
+	ref.ptr, ref.cnt = 0, 1
+	copy(ref.a[:], kInitA[:])
+	copy(ref.b[:], kInitB[:])
+	copy(ref.c[:], kInitC[:])
+}
+
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *digest) Sum(dst []byte) []byte {
+	dgt := *ref
+	hsh := [64]byte{}
+	dgt.Close(hsh[:], 0, 0)
+	return append(dst, hsh[:]...)
+}
+
+// Write more data to the running hash, never returns an error.
+func (ref *digest) Write(src []byte) (int, error) {
+	sln := uintptr(len(src))
+	fln := len(src)
+	ptr := ref.ptr
+
+	if sln < (BlockSize - ptr) {
+		copy(ref.x[ptr:], src)
+		ref.ptr += sln
+		return int(sln), nil
+	}
+
+	var m [16]uint32
+	for sln > 0 {
+		cln := BlockSize - ptr
+
+		if cln > sln {
+			cln = sln
+		}
+		sln -= cln
+
+		copy(ref.x[ptr:], src[:cln])
+		src = src[cln:]
+		ptr += cln
+
+		if ptr == BlockSize {
+			decode(&m, ref.x[:])
+			for i := range m {
+				ref.b[i] += m[i]
+			}
+			ref.xorW()
+			ref.permute(&m)
+			for i := range m {
+				ref.c[i] -= m[i]
+			}
+			ref.b, ref.c = ref.c, ref.b
+			ref.cnt++
+			ptr = 0
+		}
+	}
+
+	ref.ptr = ptr
+	return fln, nil
+}
+
+// Close the digest by writing the last bits and storing the hash
+// in dst. This prepares the digest for reuse by calling reset. A call
+// to Close with a dst that is smaller then HashSize will return an error.
+func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
+	if ln := len(dst); HashSize > ln {
+		return fmt.Errorf("Shabal Close: dst min length: %d, got %d", HashSize, ln)
+	}
+
+	ptr := ref.ptr
+
+	{
+		off := uint8(0x80) >> bcnt
+		ref.x[ptr] = uint8((bits & -off) | off)
+	}
+	memset(ref.x[ptr+1:], 0)
+
+	var m [16]uint32
+	decode(&m, ref.x[:])
+	for i := range m {
+		ref.b[i] += m[i]
+	}
+	ref.xorW()
+	ref.permute(&m)
+
+	for i := 0; i < 3; i++ {
+		ref.b, ref.c = ref.c, ref.b
+		ref.xorW()
+		ref.permute(&m)
+	}
+
+	for k := uintptr(0); k < 16; k++ {
+		encUInt32le(dst[(k<<2):], ref.b[k])
+	}
+
+	ref.Reset()
+	return nil
+}
+
+// Size returns the number of bytes Sum will return.
+func (*digest) Size() int {
+	return HashSize
+}
+
+// BlockSize returns the block size of the hash.
+func (*digest) BlockSize() int {
+	return int(BlockSize)
+}
+
+////////////////
+
+func (ref *digest) xorW() {
+	ref.a[0] ^= uint32(ref.cnt)
+	ref.a[1] ^= uint32(ref.cnt >> 32)
+}
+
+// permute applies the keyed permutation P to the state using the
+// message block m, three steps of sixteen rounds each.
+func (ref *digest) permute(m *[16]uint32) {
+	a, b, c := &ref.a, &ref.b, &ref.c
+
+	for i := range b {
+		b[i] = (b[i] << 17) | (b[i] >> 15)
+	}
+
+	for j := 0; j < 48; j++ {
+		i := j & 15
+		x0 := j % 12
+		x1 := (j + 11) % 12
+
+		a[x0] = ((a[x0] ^ (((a[x1] << 15) | (a[x1] >> 17)) * 5) ^ c[(8-i)&15]) * 3) ^
+			b[(i+13)&15] ^ (b[(i+9)&15] & ^b[(i+6)&15]) ^ m[i]
+		b[i] = ^(((b[i] << 1) | (b[i] >> 31)) ^ a[x0])
+	}
+
+	for j := 0; j < 36; j++ {
+		a[11-(j%12)] += c[(6-j)&15]
+	}
+}
+
+func memset(dst []byte, src byte) {
+	for i := range dst {
+		dst[i] = src
+	}
+}
+
+func decode(dst *[16]uint32, src []byte) {
+	for i := range dst {
+		dst[i] = decUInt32le(src[i<<2:])
+	}
+}
+
+func decUInt32le(src []byte) uint32 {
+	return (uint32(src[0]) |
+		uint32(src[1])<<8 |
+		uint32(src[2])<<16 |
+		uint32(src[3])<<24)
+}
+
+func encUInt32le(dst []byte, src uint32) {
+	dst[0] = uint8(src)
+	dst[1] = uint8(src >> 8)
+	dst[2] = uint8(src >> 16)
+	dst[3] = uint8(src >> 24)
+}
+
+////////////////
+
+var kInitA = [12]uint32{
+	uint32(0x20728DFD), uint32(0x46C0BD53), uint32(0xE782B699), uint32(0x55304632),
+	uint32(0x71B4EF90), uint32(0x0EA9E82C), uint32(0xDBB930F1), uint32(0xFAD06B8B),
+	uint32(0xBE0CAE40), uint32(0x8BD14410), uint32(0x76D2ADAC), uint32(0x28ACAB7F),
+}
+
+var kInitB = [16]uint32{
+	uint32(0xC1099CB7), uint32(0x07B385F3), uint32(0xE7442C26), uint32(0xCC8AD640),
+	uint32(0xEB6F56C7), uint32(0x1EA81AA9), uint32(0x73B9D314), uint32(0x1DE85D08),
+	uint32(0x48910A5A), uint32(0x893B22DB), uint32(0xC5A0DF44), uint32(0xBBC4324E),
+	uint32(0x72D2F240), uint32(0x75941D99), uint32(0x6D8BDE82), uint32(0xA1A7502B),
+}
+
+var kInitC = [16]uint32{
+	uint32(0xD9BF68D1), uint32(0x58BAD750), uint32(0x56028CB2), uint32(0x8134F359),
+	uint32(0xB5D469D8), uint32(0x941A8CC2), uint32(0x418B2A6E), uint32(0x04052780),
+	uint32(0x7F07D787), uint32(0x5194358F), uint32(0x3C60D665), uint32(0xBE97D79A),
+	uint32(0x950C3434), uint32(0xAED9A06D), uint32(0x2537DC8D), uint32(0x7CDB5969),
 }
@@ -1,5 +1,6 @@
 // Use of this source code is governed by an ISC
 // license that can be found in the LICENSE file.
+
 package shabal
 
 import (
@@ -10,14 +11,32 @@ import (
 	"github.com/rnichollx/go-x17/nist"
 )
 
+////////////////
+
+func TestApi(t *testing.T) {
+	dgst := New()
+	if sz := dgst.Size(); HashSize != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	if sz := dgst.BlockSize(); int(BlockSize) != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	res := [2]byte{}
+	if nil == dgst.Close(res[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
 func TestHash(t *testing.T) {
+	dgst := New()
 	out := make([]byte, 64)
 
 	for i := range tsInfo {
 		length := len(tsInfo[i].out)
 		destination := make([]byte, length)
 
-		SumBig(tsInfo[i].in[:], out)
+		dgst.Write(tsInfo[i].in[:])
+		dgst.Close(out[:], 0, 0)
 		hex.Encode(destination, out[:])
 
 		if !bytes.Equal(destination[:], tsInfo[i].out[:]) {
@@ -26,6 +45,59 @@ func TestHash(t *testing.T) {
 	}
 }
 
+func TestNistSum(t *testing.T) {
+	for i := uint64(0); i < 2048; i++ {
+		runNistSum(t, i)
+	}
+}
+
+func TestNistClose(t *testing.T) {
+	// NistResult was generated by hashing the whole bytes returned
+	// by nist.Get, so only byte aligned messages can go through Close
+	// with trailing bits, see TestCloseBits for those.
+	for i := uint64(0); i < 2048; i += 8 {
+		runNistClose(t, i)
+	}
+}
+
+func TestCloseBits(t *testing.T) {
+	for i := range tsBits {
+		dgst := New()
+		rest := [64]byte{}
+
+		dgst.Write(tsBits[i].in)
+		dgst.Close(rest[:], tsBits[i].bits, tsBits[i].bcnt)
+		hash, _ := hex.DecodeString(tsBits[i].out)
+
+		if !nist.IsEqual(hash, rest[:]) {
+			t.Errorf("\nClose bits %d:\n expected: %X\n      got: %X", i, hash, rest[:])
+		}
+	}
+}
+
+func TestWriteSplit(t *testing.T) {
+	dmsg := nist.Get(2040)
+	hash, _ := hex.DecodeString(NistResult[2040])
+
+	for step := 1; step < 20; step++ {
+		dgst := New()
+		rbuf := [64]byte{}
+
+		for off := 0; off < len(dmsg); off += step {
+			end := off + step
+			if end > len(dmsg) {
+				end = len(dmsg)
+			}
+			dgst.Write(dmsg[off:end])
+		}
+		dgst.Close(rbuf[:], 0, 0)
+
+		if !nist.IsEqual(hash, rbuf[:]) {
+			t.Errorf("\nSplit %d:\n expected: %X\n      got: %X", step, hash, rbuf[:])
+		}
+	}
+}
+
 var tsInfo = []struct {
 	id  string
 	in  []byte
@@ -48,35 +120,116 @@ var tsInfo = []struct {
 	},
 }
 
-func TestNistSum(t *testing.T) {
-	for i := uint64(0); i < 2048; i++ {
-		runNistSum(t, i)
-	}
+// tsBits holds messages with trailing bits, hashed with
+// sph_shabal512_addbits_and_close from the bundled sphlib.
+var tsBits = []struct {
+	in   []byte
+	bits uint8
+	bcnt uint8
+	out  string
+}{
+	{
+		[]byte{},
+		0x00, 1,
+		"5819A0C28164B911EB9AE4DC1D84CA02ABB501F9A19CD9124E2E8339EC44CEFD8EC3B9464DAA6FE0F3AF79E7B31B3BA15F7C47CB7A31C4858E89B4B6D47A619D",
+	},
+	{
+		[]byte{},
+		0xC0, 2,
+		"0AA3BCB3AAF08B6C68B35FAC5114AF3322A2371597C0FF952F88CF42A91AA4FD08056E1F01CF952F765056394A0F86156F872F78916E7564A1E814888AFAB71D",
+	},
+	{
+		[]byte("The quick brown fox jumps over the lazy dog"),
+		0xA0, 3,
+		"20D5423F40E41DBF626B886E8F5EDAC882EF42A401B8A06574028C2C3A7F3753FA88C940D43CB8A41538E1163548F297E1552CC69746A30BA73842ED2A71DAC8",
+	},
+	{
+		[]byte("The quick brown fox jumps over the lazy "),
+		0xA0, 3,
+		"70FF173839A24D2138FEC32200953892059850FD88F685E9E310645FBE8F55B834713F0C3E5F579097D373BA3B0136DAE4C1CD1031A69A43D880B56FC1C7C61E",
+	},
 }
 
 ////////////////
 
 func runNistSum(t *testing.T, idx uint64) {
 	if extr := idx & 7; extr == 0 {
-		rbuf := make([]byte, 64)
+		dgst := New()
+		rbuf := [64]byte{}
 		dmsg := nist.Get(idx)
 
-		SumBig(dmsg, rbuf)
+		ln, err := dgst.Write(dmsg)
+		if ln != len(dmsg) {
+			t.Errorf("\nSum Write length %d, expected: %d got: %d", idx, len(dmsg), ln)
+		}
+		if err != nil {
+			t.Errorf("\nSum Write should never return an error, %d: got: %X", idx, err)
+		}
+
+		rest := dgst.Sum(rbuf[0:0])
 		hash, _ := hex.DecodeString(NistResult[idx])
 
 		if !nist.IsEqual(hash, rbuf[:]) {
-			t.Errorf("\na) Sum %d:\n expected: %X\n      got: %X", idx, hash, rbuf[:])
+			t.Errorf("\na) Sum %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
 		}
 
-		SumBig(dmsg, rbuf)
+		// Manual reset
+		dgst.Reset()
+
+		ln, err = dgst.Write(dmsg)
+		if ln != len(dmsg) {
+			t.Errorf("\nSum Write length %d, expected: %d got: %d", idx, len(dmsg), ln)
+		}
+		if err != nil {
+			t.Errorf("\nSum Write should never return an error, %d: got: %X", idx, err)
+		}
+
+		rest = dgst.Sum(rbuf[0:0])
 		hash, _ = hex.DecodeString(NistResult[idx])
 
 		if !nist.IsEqual(hash, rbuf[:]) {
-			t.Errorf("\nb) Sum %d:\n expected: %X\n      got: %X", idx, hash, rbuf[:])
+			t.Errorf("\nb) Sum %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
 		}
 	}
 }
 
+func runNistClose(t *testing.T, idx uint64) {
+	dgst := New()
+	extr := idx & 7
+	rest := [64]byte{}
+	dmsg := nist.Get(idx)
+
+	hash, _ := hex.DecodeString(NistResult[idx])
+
+	if extr == 0 {
+		dgst.Write(dmsg)
+		dgst.Close(rest[:], 0, 0)
+	} else {
+		dgst.Write(dmsg[:len(dmsg)-1])
+		dgst.Close(rest[:], dmsg[len(dmsg)-1], uint8(extr))
+	}
+
+	if !nist.IsEqual(hash, rest[:]) {
+		t.Errorf("\na) Close %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+	}
+
+	// Auto reset
+
+	if extr == 0 {
+		dgst.Write(dmsg)
+		dgst.Close(rest[:], 0, 0)
+	} else {
+		dgst.Write(dmsg[:len(dmsg)-1])
+		dgst.Close(rest[:], dmsg[len(dmsg)-1], uint8(extr))
+	}
+
+	if !nist.IsEqual(hash, rest[:]) {
+		t.Errorf("\nb) Close %d:\n expected: %X\n      got: %X", idx, hash, rest[:])
+	}
+}
+
+////////////////
+
 var NistResult = []string{
 	"FC2D5DFF5D70B7F6B1F8C2FCC8C1F9FE9934E54257EDED0CF2B539A2EF0A19CCFFA84F8D9FA135E4BD3C09F590F3A927EBD603AC29EB729E6F2A9AF031AD8DC6",
 	"8703886B4251ECCD6861DA6EF21B4C9AA5A00793475056E05473C839EC8C7441A645D6B4EB4A876B12D3FA84963283D46DE289C599801BD46B29DAEE6642F2B1",
@@ -18,8 +18,9 @@ const BlockSize = uintptr(64)
 ////////////////
 
 type digest struct {
-	ptr uintptr
-	cnt uint64
+	ptr  uintptr
+	cnt  uint64
+	size int
 
 	h [8]uint64
 
@@ -29,6 +30,15 @@ type digest struct {
 // New returns a new digest to compute a BLAKE512 hash.
 func New() hash.Digest {
 	ref := &digest{}
+	ref.size = 64
+	ref.Reset()
+	return ref
+}
+
+// New256 returns a new digest to compute a SKEIN512-256 hash.
+func New256() hash.Digest {
+	ref := &digest{}
+	ref.size = 32
 	ref.Reset()
 	return ref
 }
@@ -38,7 +48,14 @@ func New() hash.Digest {
 // Reset resets the digest to its initial state.
 func (ref *digest) Reset() {
 	ref.ptr, ref.cnt = 0, 0
-	copy(ref.h[:], kInit[:])
+	switch ref.size {
+	case 32:
+		copy(ref.h[:], kInit256[:])
+	case 64:
+		copy(ref.h[:], kInit[:])
+	default:
+		panic("wrong digest size")
+	}
 }
 
 // Sum appends the current hash to dst and returns the result
@@ -47,7 +64,7 @@ func (ref *digest) Sum(dst []byte) []byte {
 	dgt := *ref
 	hsh := [64]byte{}
 	dgt.Close(hsh[:], 0, 0)
-	return append(dst, hsh[:]...)
+	return append(dst, hsh[:ref.size]...)
 }
 
 // Write more data to the running hash, never returns an error.
@@ -135,10 +152,10 @@ func (ref *digest) Write(src []byte) (int, error) {
 
 // Close the digest by writing the last bits and storing the hash
 // in dst. This prepares the digest for reuse by calling reset. A call
-// to Close with a dst that is smaller then HashSize will return an error.
+// to Close with a dst that is smaller then Size will return an error.
 func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
-	if ln := len(dst); HashSize > ln {
-		return fmt.Errorf("Skein Close: dst min length: %d, got %d", HashSize, ln)
+	if ln := len(dst); ref.size > ln {
+		return fmt.Errorf("Skein Close: dst min length: %d, got %d", ref.size, ln)
 	}
 
 	if bcnt != 0 {
@@ -184,7 +201,7 @@ func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
 		}
 	}
 
-	for u := uintptr(0); u < 64; u += 8 {
+	for u := 0; u < ref.size; u += 8 {
 		encUInt64le(dst[u:], h[u>>3])
 	}
 
@@ -193,8 +210,8 @@ func (ref *digest) Close(dst []byte, bits uint8, bcnt uint8) error {
 }
 
 // Size returns the number of bytes required to store the hash.
-func (*digest) Size() int {
-	return HashSize
+func (ref *digest) Size() int {
+	return ref.size
 }
 
 // BlockSize returns the block size of the hash.
@@ -394,3 +411,10 @@ var kInit = [8]uint64{
 	uint64(0x5DB62599DF6CA7B0), uint64(0xEABE394CA9D5C3F4),
 	uint64(0x991112C71A75B523), uint64(0xAE18A40B660FCC33),
 }
+
+var kInit256 = [8]uint64{
+	uint64(0xCCD044A12FDB3E13), uint64(0xE83590301A79A9EB),
+	uint64(0x55AEA0614F816E6F), uint64(0x2A2767A4AE9B94DB),
+	uint64(0xEC06025E74DD7683), uint64(0xE7A436CDC4746251),
+	uint64(0xC36FBAF9393AD185), uint64(0x3EEDBA1833EDFC13),
+}
@@ -0,0 +1,79 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package skein
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+////////////////
+
+func TestApi256(t *testing.T) {
+	dgst := New256()
+	if sz := dgst.Size(); 32 != sz {
+		t.Errorf("Size: expected: %d, got: %d", 32, sz)
+	}
+	if sz := dgst.BlockSize(); int(BlockSize) != sz {
+		t.Errorf("BlockSize: expected: %d, got: %d", BlockSize, sz)
+	}
+	res := [2]byte{}
+	if nil == dgst.Close(res[:], 0, 0) {
+		t.Error("Close: expected dst min length error, got: nil")
+	}
+}
+
+func TestHash256(t *testing.T) {
+	dgst := New256()
+	for i := range k256Result {
+		src, _ := hex.DecodeString(k256Result[i].in)
+		hash, _ := hex.DecodeString(k256Result[i].out)
+
+		dgst.Write(src)
+		if res := dgst.Sum(nil); !bytes.Equal(hash, res) {
+			t.Errorf("\nSum %d:\n expected: %X\n      got: %X", i, hash, res)
+		}
+
+		// Close resets the digest for the next round.
+		res := [32]byte{}
+		dgst.Close(res[:], 0, 0)
+		if !bytes.Equal(hash, res[:]) {
+			t.Errorf("\nClose %d:\n expected: %X\n      got: %X", i, hash, res)
+		}
+	}
+}
+
+func TestWrite256(t *testing.T) {
+	src := make([]byte, 3*BlockSize+5)
+	for i := range src {
+		src[i] = byte(i * 7)
+	}
+
+	dgst := New256()
+	dgst.Write(src)
+	want := dgst.Sum(nil)
+
+	for n := 0; n <= len(src); n += 13 {
+		dgst.Reset()
+		dgst.Write(src[:n])
+		dgst.Write(src[n:])
+		if got := dgst.Sum(nil); !bytes.Equal(want, got) {
+			t.Errorf("split at %d:\n expected: %X\n      got: %X", n, want, got)
+		}
+	}
+}
+
+////////////////
+
+// k256Result holds SKEIN512-256 digests of hex encoded messages.
+var k256Result = []struct {
+	in  string
+	out string
+}{
+	{
+		"",
+		"39CCC4554A8B31853B9DE7A1FE638A24CCE6B35A55F2431009E18780335D2621",
+	},
+}
@@ -0,0 +1,117 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package sonoa implements the Sonoa proof-of-work hash, which runs
+// seven growing variations of the x17 chain back to back.
+package sonoa
+
+import (
+	"errors"
+	"fmt"
+
+	x17 "github.com/rnichollx/go-x17"
+	"github.com/rnichollx/go-x17/chain"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("sonoa: dst shorter than HashSize")
+
+////////////////
+
+// Hash contains the state objects
+// required to perform the sonoa.Hash.
+type Hash struct {
+	tha [64]byte
+	thb [64]byte
+
+	x17 *x17.Hash
+}
+
+// New returns a new object to compute a sonoa hash. Every stage runs on
+// the digest objects of a single x17.Hash, see x17.Hash.RunStage.
+func New() *Hash {
+	return &Hash{x17: x17.New()}
+}
+
+// Hash computes the hash from the src bytes and stores the result in dst,
+// errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash from the src bytes and stores the HAVAL256
+// digest closing the last round in dst, byte swapped like x17.Hash. A
+// call to Compute with a dst that is smaller then HashSize returns
+// ErrShortDst.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	buf := [2][]byte{ref.tha[:], ref.thb[:]}
+
+	n := 0
+	ta := src
+	for r := range kRounds {
+		for _, a := range kRounds[r] {
+			tb := buf[n&1]
+			n++
+
+			if err := ref.x17.RunStage(a.String(), ta, tb); err != nil {
+				return fmt.Errorf("sonoa: round %d: %w", r+1, err)
+			}
+			ta = tb
+		}
+	}
+
+	tb := buf[n&1]
+	if err := ref.x17.RunStage("haval", ta, tb); err != nil {
+		return fmt.Errorf("sonoa: round %d: %w", len(kRounds), err)
+	}
+	for i := 0; i < HashSize; i++ {
+		dst[i] = tb[HashSize-1-i]
+	}
+	return nil
+}
+
+////////////////
+
+// kRounds lists the 512 bit stages of the seven rounds, the last
+// round is closed by HAVAL256 for 97 stage invocations in total.
+var kRounds = [7][]chain.Algo{
+	{
+		chain.Blake, chain.BMW, chain.Groestl, chain.Skein, chain.JH, chain.Keccak,
+		chain.Luffa, chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo,
+	},
+	{
+		chain.BMW, chain.Groestl, chain.Skein, chain.JH, chain.Keccak, chain.Luffa,
+		chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi,
+	},
+	{
+		chain.BMW, chain.Groestl, chain.Skein, chain.JH, chain.Keccak, chain.Luffa,
+		chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi, chain.Fugue,
+	},
+	{
+		chain.BMW, chain.Groestl, chain.Skein, chain.JH, chain.Keccak, chain.Luffa,
+		chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi, chain.Fugue,
+		chain.Shabal, chain.Hamsi, chain.Echo, chain.Shavite,
+	},
+	{
+		chain.BMW, chain.Shabal, chain.Groestl, chain.Skein, chain.JH, chain.Keccak,
+		chain.Luffa, chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi,
+		chain.Fugue, chain.Shabal, chain.Whirlpool,
+	},
+	{
+		chain.BMW, chain.Groestl, chain.Skein, chain.JH, chain.Keccak, chain.Luffa,
+		chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi, chain.Fugue,
+		chain.Shabal, chain.Whirlpool, chain.SHA512, chain.Whirlpool,
+	},
+	{
+		chain.BMW, chain.Groestl, chain.Skein, chain.JH, chain.Keccak, chain.Luffa,
+		chain.CubeHash, chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi, chain.Fugue,
+		chain.Shabal, chain.Whirlpool, chain.SHA512,
+	},
+}
@@ -0,0 +1,131 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package sonoa
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	x17 "github.com/rnichollx/go-x17"
+	"github.com/rnichollx/go-x17/haval"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		hs.Hash([]byte(tsInfo[i].in), out[:])
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-sonoa]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	hs := New()
+
+	n := 1
+	for _, round := range kRounds {
+		n += len(round)
+	}
+	if n != 97 {
+		t.Errorf("expected 97 stage invocations, got: %d", n)
+	}
+
+	for i := range tsInfo {
+		exp := []byte(tsInfo[i].in)
+		for _, round := range kRounds {
+			for _, a := range round {
+				dgst := a.New()
+				dgst.Write(exp)
+				exp = dgst.Sum(nil)
+			}
+		}
+		hv := haval.New()
+		hv.Update(exp, 0, len(exp))
+		exp = hv.Digest()
+		for l, r := 0, HashSize-1; l < r; l, r = l+1, r-1 {
+			exp[l], exp[r] = exp[r], exp[l]
+		}
+
+		res := [HashSize]byte{}
+		if err := hs.Compute([]byte(tsInfo[i].in), res[:]); err != nil {
+			t.Fatalf("[%s-sonoa]: %v", tsInfo[i].id, err)
+		}
+		if !bytes.Equal(exp, res[:]) {
+			t.Errorf("[%s-sonoa]: invalid hash \nexpected:	%X, \ngot:		%X", tsInfo[i].id, exp, res)
+		}
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	hs := New()
+	out := [HashSize]byte{}
+
+	allocs := testing.AllocsPerRun(8, func() {
+		hs.Hash(kBench, out[:])
+	})
+	if allocs != 0 {
+		t.Errorf("Hash: expected 0 allocations, got: %v", allocs)
+	}
+}
+
+func TestShortDst(t *testing.T) {
+	if err := New().Compute(nil, make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+}
+
+func BenchmarkSonoa(b *testing.B) {
+	hs := New()
+	out := [HashSize]byte{}
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(kBench)))
+	for i := 0; i < b.N; i++ {
+		hs.Hash(kBench, out[:])
+	}
+}
+
+func BenchmarkX17(b *testing.B) {
+	hs := x17.New()
+	out := [HashSize]byte{}
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(kBench)))
+	for i := 0; i < b.N; i++ {
+		hs.Hash(kBench, out[:])
+	}
+}
+
+////////////////
+
+// kBench is an 80 byte block header.
+var kBench = make([]byte, 80)
+
+// Byte swapped like x17.Hash. No Sonoa chain header could be checked
+// yet, so these vectors only pin the current output.
+var tsInfo = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Empty",
+		"",
+		"148548dddacf8fe4442f39447b1788ef8a18fa5a210f1f87c485a43254b8b2e1",
+	},
+	{
+		"SONO",
+		"SONO",
+		"a59e61a9e68d08a597943ee86b13070b294c4925b563aab5f52f747ea9386d30",
+	},
+	{
+		"Fox",
+		"The quick brown fox jumps over the lazy dog",
+		"b9e5e1a3792c0fbf6172867979e70c8c439dd9e10f4116d0b8e476f55833de8b",
+	},
+}
@@ -0,0 +1,57 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"crypto/sha512"
+	"fmt"
+)
+
+////////////////
+
+// RunStage runs the stage called name, one of StageNames, over src with
+// the digest objects of ref and stores its output in dst: 64 bytes, or
+// the 32 byte digest for haval. It lets packages like sonoa run the x17
+// stages in their own order without a digest set of their own. Any data
+// previously passed to Write is discarded. A call to RunStage with a dst
+// that is smaller then 64 bytes returns ErrShortStageDst, an unknown
+// name an error wrapping ErrUnknownStage and a failing stage a
+// *StageError.
+func (ref *Hash) RunStage(name string, src []byte, dst []byte) error {
+	if ln := len(dst); 64 > ln {
+		return ErrShortStageDst
+	}
+
+	switch name {
+	case "blake":
+		ref.blake.Reset()
+		return ref.stage(name, ref.blake, src, dst[:64])
+	case "whirlpool":
+		ref.whirlpool.Reset()
+		ref.whirlpool.Write(src)
+		ref.whirlpool.Sum(dst[:0])
+		ref.mark(name, dst[:64])
+		return nil
+	case "sha512":
+		sum := sha512.Sum512(src)
+		copy(dst, sum[:])
+		ref.mark(name, dst[:64])
+		return nil
+	case "haval":
+		ref.haval.Update(src, 0, len(src))
+		if err := ref.haval.Close(dst); err != nil {
+			return &StageError{Stage: name, Err: err}
+		}
+		ref.mark(name, dst[:HashSize])
+		return nil
+	}
+
+	stages := ref.digests()
+	for i := range stages {
+		if stages[i].name == name {
+			return ref.stage(name, stages[i].dgst, src, dst[:64])
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrUnknownStage, name)
+}
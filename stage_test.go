@@ -0,0 +1,43 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRunStage(t *testing.T) {
+	hs := New()
+	out := [HashSize]byte{}
+
+	trace, err := hs.HashTrace(hexBlockVerge, out[:])
+	if err != nil {
+		t.Fatalf("HashTrace: %v", err)
+	}
+
+	// Streamed data must not leak into the blake stage.
+	hs.Write([]byte("XVG"))
+
+	ta, tb := make([]byte, 64), make([]byte, 64)
+	in := hexBlockVerge
+	for i, name := range StageNames {
+		if err := hs.RunStage(name, in, ta); err != nil {
+			t.Fatalf("RunStage %s: %v", name, err)
+		}
+		exp := trace[i].Out
+		if !bytes.Equal(exp, ta[:len(exp)]) {
+			t.Errorf("RunStage %s: expected: %X, got: %X", name, exp, ta[:len(exp)])
+		}
+		in, ta, tb = ta, tb, ta
+	}
+
+	if err := hs.RunStage("bmw", nil, ta[:63]); err != ErrShortStageDst {
+		t.Errorf("RunStage: expected: %v, got: %v", ErrShortStageDst, err)
+	}
+	if err := hs.RunStage("gost", nil, ta); !errors.Is(err, ErrUnknownStage) {
+		t.Errorf("RunStage: expected: %v, got: %v", ErrUnknownStage, err)
+	}
+}
@@ -0,0 +1,28 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import "sync"
+
+////////////////
+
+// pool holds idle Hash objects for the package level functions,
+// a Hash is not safe for concurrent use so each call takes its own.
+var pool = sync.Pool{
+	New: func() interface{} {
+		return New()
+	},
+}
+
+// Sum256 returns the x17 hash of data. It is safe
+// for concurrent use by multiple goroutines.
+func Sum256(data []byte) [HashSize]byte {
+	res := [HashSize]byte{}
+
+	ref := pool.Get().(*Hash)
+	ref.Hash(data, res[:])
+	pool.Put(ref)
+
+	return res
+}
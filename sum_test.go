@@ -0,0 +1,42 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"encoding/hex"
+	"sync"
+	"testing"
+)
+
+func TestSum256(t *testing.T) {
+	for i := range tsInfo {
+		res := Sum256(tsInfo[i].in)
+
+		if dest := hex.EncodeToString(res[:]); dest != string(tsInfo[i].out17) {
+			t.Errorf("[%s-x17]: invalid hash \nexpected:	%64s, \ngot:		%64s", tsInfo[i].id, tsInfo[i].out17[:], dest)
+		}
+	}
+}
+
+func TestSum256Concurrent(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for g := 0; g < 32; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+
+			for n := 0; n < 50; n++ {
+				i := (g + n) % len(tsInfo)
+				res := Sum256(tsInfo[i].in)
+
+				if dest := hex.EncodeToString(res[:]); dest != string(tsInfo[i].out17) {
+					t.Errorf("[%s-x17] goroutine %d: invalid hash \nexpected:	%64s, \ngot:		%64s", tsInfo[i].id, g, tsInfo[i].out17[:], dest)
+				}
+			}
+		}(g)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,179 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package timetravel implements the Timetravel and Timetravel10 hashes,
+// which run their digests in a permutation picked by the header time.
+package timetravel
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/chain"
+	"github.com/rnichollx/go-x17/hash"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// HeaderSize holds the minimum size of a block header in bytes.
+const HeaderSize = int(80)
+
+const (
+	// BaseTimestamp is the Machinecoin genesis time, the
+	// epoch the Timetravel permutation counts from.
+	BaseTimestamp = uint32(1389040865)
+
+	// BaseTimestamp10 is the Bitcore genesis time, the
+	// epoch the Timetravel10 permutation counts from.
+	BaseTimestamp10 = uint32(1492973331)
+)
+
+const (
+	// Permutations holds the number of stage orders
+	// Timetravel cycles through, 8!.
+	Permutations = uint32(40320)
+
+	// Permutations10 holds the number of stage orders Timetravel10
+	// cycles through. The Bitcore reference keeps the count of
+	// Timetravel, so blake and bmw always run first.
+	Permutations10 = uint32(40320)
+)
+
+var (
+	// ErrShortDst is returned when dst can not hold HashSize bytes.
+	ErrShortDst = errors.New("timetravel: dst shorter than HashSize")
+
+	// ErrShortHeader is returned when src is not a full block header.
+	ErrShortHeader = errors.New("timetravel: header shorter than HeaderSize")
+)
+
+////////////////
+
+// Hash contains the state objects required
+// to perform a Timetravel hash.
+type Hash struct {
+	tha [64]byte
+	thb [64]byte
+
+	base  uint32
+	perms uint32
+	algo  []chain.Algo
+	dgst  []hash.Digest
+	perm  []int
+}
+
+// New returns a new object to compute a Timetravel hash.
+func New() *Hash {
+	return newHash(BaseTimestamp, Permutations, kAlgo[:8])
+}
+
+// New10 returns a new object to compute a Timetravel10 hash.
+func New10() *Hash {
+	return newHash(BaseTimestamp10, Permutations10, kAlgo[:10])
+}
+
+func newHash(base, perms uint32, algo []chain.Algo) *Hash {
+	ref := &Hash{
+		base:  base,
+		perms: perms,
+		algo:  algo,
+		dgst:  make([]hash.Digest, len(algo)),
+		perm:  make([]int, len(algo)),
+	}
+	for i, a := range algo {
+		ref.dgst[i] = a.New()
+	}
+	return ref
+}
+
+// Order returns the stages in the order they run for a header
+// with the given time.
+func (ref *Hash) Order(ntime uint32) []chain.Algo {
+	ref.permute(ntime)
+
+	res := make([]chain.Algo, len(ref.perm))
+	for i, p := range ref.perm {
+		res[i] = ref.algo[p]
+	}
+	return res
+}
+
+// Hash computes the hash of the header held in src and stores the
+// result in dst, errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash of the header held in src and stores the
+// result in dst, byte swapped like x17.Hash. The time is read from the
+// first HeaderSize bytes of src, all of src is hashed.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+	if len(src) < HeaderSize {
+		return ErrShortHeader
+	}
+
+	ref.permute(binary.LittleEndian.Uint32(src[68:]))
+	buf := [2][]byte{ref.tha[:], ref.thb[:]}
+
+	ta := src
+	for i, p := range ref.perm {
+		tb := buf[i&1]
+
+		dgst := ref.dgst[p]
+		dgst.Reset()
+		dgst.Write(ta)
+		if err := dgst.Close(tb, 0, 0); err != nil {
+			return fmt.Errorf("timetravel: stage %v: %w", ref.algo[p], err)
+		}
+		ta = tb
+	}
+
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ta[HashSize-1-i]
+	}
+	return nil
+}
+
+////////////////
+
+// permute stores in perm the lexicographic permutation reached by
+// stepping the sorted order forward once per second since the base
+// time, modulo the permutation count of the variant. The subtraction
+// wraps like the uint32 arithmetic of the reference miner.
+func (ref *Hash) permute(ntime uint32) {
+	n := len(ref.perm)
+	steps := int((ntime - ref.base) % ref.perms)
+
+	fact := 1
+	for i := 2; i <= n; i++ {
+		fact *= i
+	}
+
+	for i := range ref.perm {
+		ref.perm[i] = i
+	}
+
+	// Decode steps in the factorial number system, every digit
+	// picks one of the remaining stages.
+	for i := 0; i < n-1; i++ {
+		fact /= n - i
+		k := i + steps/fact
+		steps %= fact
+
+		v := ref.perm[k]
+		copy(ref.perm[i+1:k+1], ref.perm[i:k])
+		ref.perm[i] = v
+	}
+}
+
+// kAlgo lists the Timetravel10 stages in their sorted order,
+// Timetravel uses the first eight.
+var kAlgo = [10]chain.Algo{
+	chain.Blake, chain.BMW, chain.Groestl, chain.Skein, chain.JH,
+	chain.Keccak, chain.Luffa, chain.CubeHash, chain.Shavite, chain.SIMD,
+}
@@ -0,0 +1,191 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package timetravel
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/chain"
+)
+
+func TestHash(t *testing.T) {
+	for _, ts := range tsInfo {
+		hs := ts.ctor()
+		out := [HashSize]byte{}
+
+		if err := hs.Compute(ts.header(), out[:]); err != nil {
+			t.Fatalf("[%s]: %v", ts.id, err)
+		}
+		if res := hex.EncodeToString(out[:]); res != ts.out {
+			t.Errorf("[%s]: invalid hash \nexpected:	%s, \ngot:		%s", ts.id, ts.out, res)
+		}
+	}
+}
+
+// TestOrder compares the decoded permutation with stepping
+// next_permutation forward as the reference miner does.
+func TestOrder(t *testing.T) {
+	hs := New()
+	perm := []int{0, 1, 2, 3, 4, 5, 6, 7}
+
+	for s := uint32(0); s < 40320+3; s++ {
+		ord := hs.Order(BaseTimestamp + s)
+		for i := range perm {
+			if ord[i] != kAlgo[perm[i]] {
+				t.Fatalf("[%d]: expected: %v, got: %v", s, perm, ord)
+			}
+		}
+		nextPermutation(perm)
+	}
+
+	// A time before the base wraps around.
+	ord := hs.Order(BaseTimestamp - 1)
+	exp := (^uint32(0)) % 40320
+	if ref := hs.Order(BaseTimestamp + exp); !equal(ord, ref) {
+		t.Errorf("wrap: expected: %v, got: %v", ref, ord)
+	}
+
+	// Timetravel10 wraps after Permutations10 steps, so the sequence
+	// starts over before blake or bmw leave the front.
+	hs10 := New10()
+	perm = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for s := uint32(0); s < 2*Permutations10+3; s++ {
+		if s%Permutations10 == 0 {
+			perm = []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		}
+		ord := hs10.Order(BaseTimestamp10 + s)
+		for i := range perm {
+			if ord[i] != kAlgo[perm[i]] {
+				t.Fatalf("[%d]: expected: %v, got: %v", s, perm, ord)
+			}
+		}
+		if ord[0] != chain.Blake || ord[1] != chain.BMW {
+			t.Fatalf("[%d]: expected blake and bmw first, got: %v", s, ord)
+		}
+		nextPermutation(perm)
+	}
+}
+
+func TestChain(t *testing.T) {
+	header := make([]byte, HeaderSize)
+	for i := range header {
+		header[i] = uint8(i * 37)
+	}
+
+	for _, hs := range []*Hash{New(), New10()} {
+		ref := chain.NewPow(nil, hs.Order(binary.LittleEndian.Uint32(header[68:]))...)
+		exp := [HashSize]byte{}
+		ref.Hash(header, exp[:])
+
+		res := [HashSize]byte{}
+		hs.Hash(header, res[:])
+		if res != exp {
+			t.Errorf("expected: %X, got: %X", exp, res)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	hs := New10()
+
+	if err := hs.Compute(make([]byte, HeaderSize-1), make([]byte, HashSize)); err != ErrShortHeader {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortHeader, err)
+	}
+	if err := hs.Compute(make([]byte, HeaderSize), make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+
+	out := make([]byte, HashSize)
+	hs.Hash(nil, out)
+	if !bytes.Equal(out, make([]byte, HashSize)) {
+		t.Errorf("Hash: expected dst to be left untouched, got: %X", out)
+	}
+}
+
+////////////////
+
+// nextPermutation is std::next_permutation, as used by the reference miner.
+func nextPermutation(p []int) {
+	i := len(p) - 2
+	for i >= 0 && p[i] >= p[i+1] {
+		i--
+	}
+	if i >= 0 {
+		k := len(p) - 1
+		for p[k] <= p[i] {
+			k--
+		}
+		p[i], p[k] = p[k], p[i]
+	}
+	for a, b := i+1, len(p)-1; a < b; a, b = a+1, b-1 {
+		p[a], p[b] = p[b], p[a]
+	}
+}
+
+func equal(a, b []chain.Algo) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func header(ntime uint32) func() []byte {
+	return func() []byte {
+		res := make([]byte, HeaderSize)
+		for i := range res {
+			res[i] = uint8(i)
+		}
+		binary.LittleEndian.PutUint32(res[68:], ntime)
+		return res
+	}
+}
+
+// Pinned output in block explorer order. Machinecoin and Bitcore blocks
+// are still owed, TestOrder and TestChain cover the permutation and the
+// stages meanwhile.
+var tsInfo = []struct {
+	id     string
+	ctor   func() *Hash
+	header func() []byte
+	out    string
+}{
+	{
+		"Timetravel base",
+		New,
+		header(BaseTimestamp),
+		"1bda2b3ea77281f9e9aaaba99a1a198b4c34e08868b67d96faa6fd18bbd57eed",
+	},
+	{
+		"Timetravel 2018",
+		New,
+		header(1514764800),
+		"561b2a7be366a9caf9ba73530a58131d8a721391361e5914683b961359fc43e2",
+	},
+	{
+		"Timetravel10 base",
+		New10,
+		header(BaseTimestamp10),
+		"dd28acc27aa20b4f39dd22fc3b404a9593bdd3944c23bb80b9f4d7ee247dcb68",
+	},
+	{
+		"Timetravel10 2018",
+		New10,
+		header(1514764800),
+		"0b7765e211bda622dbe57db5b6692a1aa53d82eaed318300079666e0148fcbed",
+	},
+	{
+		"Timetravel10 wrap",
+		New10,
+		header(BaseTimestamp10 + 3*Permutations10 + 5),
+		"682870eddc2aedfc6cb36b41f4eafe85f18b85f0354ce599ef1c684759b1ca0a",
+	},
+}
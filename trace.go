@@ -0,0 +1,58 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import "time"
+
+////////////////
+
+// Stage holds the output of a single step of the chain
+// together with the time spent in that step.
+type Stage struct {
+	Name    string
+	Out     []byte
+	Elapsed time.Duration
+}
+
+// HashTrace works like Compute but also returns the output of all 17
+// stages in chain order, from "blake" to "haval". Every Out holds
+// 64 bytes, except for haval which holds its 32 byte digest before
+// the final byte swap that produces dst. On error the trace holds the
+// stages that completed, none for a dst that is smaller then HashSize.
+func (ref *Hash) HashTrace(src []byte, dst []byte) ([]Stage, error) {
+	ref.tracing = true
+	ref.trace = make([]Stage, 0, len(StageNames))
+	ref.tick = time.Now()
+
+	err := ref.Compute(src, dst)
+
+	res := ref.trace
+	ref.tracing, ref.trace = false, nil
+	return res, err
+}
+
+// StageNames lists the stages of the x17 chain in order.
+var StageNames = []string{
+	"blake", "bmw", "groestl", "skein", "jh", "keccak",
+	"luffa", "cubehash", "shavite", "simd", "echo", "hamsi",
+	"fugue", "shabal", "whirlpool", "sha512", "haval",
+}
+
+////////////////
+
+// mark records the output of a stage, it is a no-op unless
+// called from HashTrace.
+func (ref *Hash) mark(name string, out []byte) {
+	if !ref.tracing {
+		return
+	}
+
+	now := time.Now()
+	ref.trace = append(ref.trace, Stage{
+		Name:    name,
+		Out:     append([]byte(nil), out...),
+		Elapsed: now.Sub(ref.tick),
+	})
+	ref.tick = time.Now()
+}
@@ -0,0 +1,75 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"testing"
+
+	"github.com/rnichollx/go-x17/blake"
+	"github.com/rnichollx/go-x17/bmw"
+	"github.com/rnichollx/go-x17/shabal"
+)
+
+func TestHashTrace(t *testing.T) {
+	hs := New()
+	out17 := [32]byte{}
+	res17 := [32]byte{}
+
+	trace, err := hs.HashTrace(hexBlockVerge, out17[:])
+	if err != nil {
+		t.Fatalf("HashTrace: %v", err)
+	}
+	hs.Hash(hexBlockVerge, res17[:])
+
+	if out17 != res17 {
+		t.Errorf("HashTrace: result differs from Hash \nexpected:	%X, \ngot:		%X", res17, out17)
+	}
+
+	if len(trace) != len(StageNames) {
+		t.Fatalf("expected %d stages, got: %d", len(StageNames), len(trace))
+	}
+	for i := range trace {
+		if trace[i].Name != StageNames[i] {
+			t.Errorf("stage %d: expected name %s, got: %s", i, StageNames[i], trace[i].Name)
+		}
+		if ln := len(trace[i].Out); ln != 64 && trace[i].Name != "haval" {
+			t.Errorf("stage %s: expected 64 bytes, got: %d", trace[i].Name, ln)
+		}
+	}
+
+	step := func(dgst interface {
+		Write([]byte) (int, error)
+		Sum([]byte) []byte
+	}, src []byte) []byte {
+		dgst.Write(src)
+		return dgst.Sum(nil)
+	}
+
+	if exp := step(blake.New(), hexBlockVerge); !bytes.Equal(exp, trace[0].Out) {
+		t.Errorf("blake: expected: %X, got: %X", exp, trace[0].Out)
+	}
+	if exp := step(bmw.New(), trace[0].Out); !bytes.Equal(exp, trace[1].Out) {
+		t.Errorf("bmw: expected: %X, got: %X", exp, trace[1].Out)
+	}
+	if exp := step(shabal.New(), trace[12].Out); !bytes.Equal(exp, trace[13].Out) {
+		t.Errorf("shabal: expected: %X, got: %X", exp, trace[13].Out)
+	}
+	if exp := sha512.Sum512(trace[14].Out); !bytes.Equal(exp[:], trace[15].Out) {
+		t.Errorf("sha512: expected: %X, got: %X", exp, trace[15].Out)
+	}
+
+	if again, _ := hs.HashTrace(hexBlockVerge, out17[:]); len(again) != len(StageNames) {
+		t.Errorf("expected %d stages on reuse, got: %d", len(StageNames), len(again))
+	}
+
+	short, err := hs.HashTrace(hexBlockVerge, out17[:HashSize-1])
+	if err != ErrShortDst {
+		t.Errorf("HashTrace: expected: %v, got: %v", ErrShortDst, err)
+	}
+	if len(short) != 0 {
+		t.Errorf("HashTrace: expected no stages for a short dst, got: %d", len(short))
+	}
+}
@@ -0,0 +1,38 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package veltor implements the Veltor proof-of-work hash.
+package veltor
+
+import (
+	"errors"
+
+	"github.com/rnichollx/go-x17/chain"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = chain.HashSize
+
+// ErrShortDst is returned when dst can not hold HashSize bytes.
+var ErrShortDst = errors.New("veltor: dst shorter than HashSize")
+
+// Stages returns the stages of the Veltor hash.
+func Stages() []chain.Algo {
+	return append([]chain.Algo(nil), stages...)
+}
+
+var stages = []chain.Algo{
+	chain.Skein, chain.Shavite, chain.Shabal, chain.GOST,
+}
+
+////////////////
+
+// Hash contains the state objects required to perform the veltor.Hash,
+// its Compute stores the first HashSize bytes of the GOST512 digest in
+// dst, byte swapped like x17.Hash.
+type Hash = chain.Pow
+
+// New returns a new object to compute a veltor hash.
+func New() *Hash {
+	return chain.NewPow(ErrShortDst, stages...)
+}
@@ -0,0 +1,87 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package veltor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/rnichollx/go-x17/gost"
+	"github.com/rnichollx/go-x17/hash"
+	"github.com/rnichollx/go-x17/shabal"
+	"github.com/rnichollx/go-x17/shavite"
+	"github.com/rnichollx/go-x17/skein"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		hs.Hash([]byte(tsInfo[i].in), out[:])
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-veltor]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+func TestCompose(t *testing.T) {
+	hs := New()
+
+	for i := range tsInfo {
+		exp := []byte(tsInfo[i].in)
+		for _, dgst := range []hash.Digest{skein.New(), shavite.New(), shabal.New(), gost.New512LE()} {
+			dgst.Write(exp)
+			exp = dgst.Sum(nil)
+		}
+
+		res := [HashSize]byte{}
+		if err := hs.Compute([]byte(tsInfo[i].in), res[:]); err != nil {
+			t.Fatalf("[%s]: %v", tsInfo[i].id, err)
+		}
+		for l, r := 0, HashSize-1; l < r; l, r = l+1, r-1 {
+			exp[l], exp[r] = exp[r], exp[l]
+		}
+		if !bytes.Equal(exp[:HashSize], res[:]) {
+			t.Errorf("[%s-veltor]: invalid hash \nexpected:	%X, \ngot:		%X", tsInfo[i].id, exp[:HashSize], res)
+		}
+	}
+}
+
+func TestShortDst(t *testing.T) {
+	if err := New().Compute(nil, make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+	if msg := ErrShortDst.Error(); !strings.HasPrefix(msg, "veltor: ") {
+		t.Errorf("ErrShortDst: expected a veltor prefix, got: %s", msg)
+	}
+}
+
+////////////////
+
+// Hashes are in block explorer order. Without a Veltor mainnet header to
+// compare with, these only guard against regressions.
+var tsInfo = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Empty",
+		"",
+		"bcfd5c5fde009c1d9ae7e44b2a4f2d403f989c1ac7ef23efe19d8ef19886e2f4",
+	},
+	{
+		"Veltor",
+		"veltor",
+		"d6fc8003a9c94086af6e2611b7c0641b7ab76c93e3e109f10591d8113a998635",
+	},
+	{
+		"Fox",
+		"The quick brown fox jumps over the lazy dog",
+		"dd51730bfc424d0a863671e01d4798a57a4091b3b37f159c649df2d63f672a41",
+	},
+}
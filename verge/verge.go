@@ -0,0 +1,163 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package verge implements the Verge multi-algorithm proof-of-work,
+// which picks the hash of every block from the algorithm bits of its
+// version.
+package verge
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	x17 "github.com/rnichollx/go-x17"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// HeaderSize holds the minimum size of a block header in bytes.
+const HeaderSize = int(80)
+
+// AlgoMask selects the algorithm bits of a block version.
+const AlgoMask = uint32(15 << 11)
+
+var (
+	// ErrShortDst is returned when dst can not hold HashSize bytes.
+	ErrShortDst = errors.New("verge: dst shorter than HashSize")
+
+	// ErrShortHeader is returned when src is not a full block header.
+	ErrShortHeader = errors.New("verge: header shorter than HeaderSize")
+
+	// ErrUnsupported is returned, wrapped with the name of the
+	// algorithm, for an algorithm this package can not compute.
+	ErrUnsupported = errors.New("verge: unsupported algorithm")
+)
+
+////////////////
+
+// Algo identifies one of the Verge proof-of-work algorithms, the values
+// follow the algorithm numbers of the Verge node.
+type Algo int
+
+const (
+	// Scrypt is the original Verge algorithm.
+	Scrypt Algo = iota
+	// MyrGroestl is Myriad-Groestl, GROESTL512 followed by SHA256.
+	MyrGroestl
+	// X17 is the seventeen stage chain of the x17 package.
+	X17
+	// Blake2s is BLAKE2s-256.
+	Blake2s
+	// Lyra2REv2 is the chain of the lyra2rev2 package.
+	Lyra2REv2
+)
+
+// String returns the name of the algorithm.
+func (a Algo) String() string {
+	switch a {
+	case Scrypt:
+		return "scrypt"
+	case MyrGroestl:
+		return "myr-groestl"
+	case X17:
+		return "x17"
+	case Blake2s:
+		return "blake2s"
+	case Lyra2REv2:
+		return "lyra2rev2"
+	}
+	return fmt.Sprintf("Algo(%d)", int(a))
+}
+
+// Version returns the algorithm bits of a block version using a, an
+// unknown algorithm has none.
+func (a Algo) Version() uint32 {
+	switch a {
+	case Scrypt:
+		return 1 << 11
+	case MyrGroestl:
+		return 2 << 11
+	case X17:
+		return 3 << 11
+	case Blake2s:
+		return 4 << 11
+	case Lyra2REv2:
+		return 10 << 11
+	}
+	return 0
+}
+
+// AlgoOf returns the algorithm of a block with the given version. Like
+// the Verge node it falls back to Scrypt for bits it does not know,
+// which covers the blocks from before the algorithm bits were used.
+func AlgoOf(version uint32) Algo {
+	switch version & AlgoMask {
+	case MyrGroestl.Version():
+		return MyrGroestl
+	case X17.Version():
+		return X17
+	case Blake2s.Version():
+		return Blake2s
+	case Lyra2REv2.Version():
+		return Lyra2REv2
+	}
+	return Scrypt
+}
+
+// HeaderAlgo returns the algorithm of the block header held in src.
+func HeaderAlgo(src []byte) (Algo, error) {
+	if len(src) < HeaderSize {
+		return Scrypt, ErrShortHeader
+	}
+	return AlgoOf(binary.LittleEndian.Uint32(src)), nil
+}
+
+////////////////
+
+// Hash contains the state objects required to
+// perform the hash of every supported algorithm.
+type Hash struct {
+	x17 *x17.Hash
+}
+
+// New returns a new object to compute Verge proof-of-work hashes.
+func New() *Hash {
+	return &Hash{x17: x17.New()}
+}
+
+// Hash computes the hash of the header held in src and stores the
+// result in dst, errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash of the header held in src with the
+// algorithm picked by its version, and stores the result in dst.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	algo, err := HeaderAlgo(src)
+	if err != nil {
+		return err
+	}
+	return ref.ComputeAlgo(algo, src, dst)
+}
+
+// ComputeAlgo computes the hash of src with algo and stores the result
+// in dst, byte swapped like x17.Hash. A call with an algorithm this
+// package can not compute returns an error wrapping ErrUnsupported.
+//
+// Only X17 is dispatched. The lyra2rev2 and groestlpow packages are not
+// wired in until Verge mainnet headers of those algorithms confirm both
+// the hashes and their version bits.
+func (ref *Hash) ComputeAlgo(algo Algo, src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	switch algo {
+	case X17:
+		return ref.x17.Compute(src, dst)
+	}
+	return fmt.Errorf("%w: %v", ErrUnsupported, algo)
+}
@@ -0,0 +1,158 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package verge
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	x17 "github.com/rnichollx/go-x17"
+)
+
+func TestHash(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsInfo {
+		in, _ := hex.DecodeString(tsInfo[i].in)
+
+		algo, err := HeaderAlgo(in)
+		if err != nil {
+			t.Fatalf("[%s-verge]: %v", tsInfo[i].id, err)
+		}
+		if algo != tsInfo[i].algo {
+			t.Errorf("[%s-verge]: algo expected: %v, got: %v", tsInfo[i].id, tsInfo[i].algo, algo)
+		}
+
+		if err := hs.Compute(in, out[:]); err != nil {
+			t.Fatalf("[%s-verge]: %v", tsInfo[i].id, err)
+		}
+		if res := hex.EncodeToString(out[:]); res != tsInfo[i].out {
+			t.Errorf("[%s-verge]: invalid hash \nexpected:	%s, \ngot:		%s", tsInfo[i].id, tsInfo[i].out, res)
+		}
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	hs := New()
+	in, _ := hex.DecodeString(tsInfo[0].in)
+
+	hdr := append([]byte{}, in...)
+	binary.LittleEndian.PutUint32(hdr, 4|X17.Version())
+
+	exp := [HashSize]byte{}
+	if err := x17.New().Compute(hdr, exp[:]); err != nil {
+		t.Fatalf("[%v]: %v", X17, err)
+	}
+
+	res := [HashSize]byte{}
+	if err := hs.Compute(hdr, res[:]); err != nil {
+		t.Fatalf("[%v]: %v", X17, err)
+	}
+	if !bytes.Equal(exp[:], res[:]) {
+		t.Errorf("[%v]: invalid hash \nexpected:	%X, \ngot:		%X", X17, exp, res)
+	}
+}
+
+func TestAlgoOf(t *testing.T) {
+	for _, a := range []Algo{Scrypt, MyrGroestl, X17, Blake2s, Lyra2REv2} {
+		if res := AlgoOf(4 | a.Version()); res != a {
+			t.Errorf("AlgoOf(%#x): expected: %v, got: %v", 4|a.Version(), a, res)
+		}
+	}
+
+	for _, v := range []uint32{1, 2, 4, 5 << 11, 15 << 11} {
+		if res := AlgoOf(v); res != Scrypt {
+			t.Errorf("AlgoOf(%#x): expected: %v, got: %v", v, Scrypt, res)
+		}
+	}
+
+	if v := Algo(-1).Version(); v != 0 {
+		t.Errorf("Version: expected 0 for an unknown algo, got: %#x", v)
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	hs := New()
+	in, _ := hex.DecodeString(tsInfo[0].in)
+
+	for _, a := range []Algo{Scrypt, MyrGroestl, Blake2s, Lyra2REv2, Algo(9)} {
+		out := [HashSize]byte{}
+		err := hs.ComputeAlgo(a, in, out[:])
+		if !errors.Is(err, ErrUnsupported) {
+			t.Errorf("[%v]: expected: %v, got: %v", a, ErrUnsupported, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), a.String()) {
+			t.Errorf("[%v]: error does not name the algo: %v", a, err)
+		}
+		if out != [HashSize]byte{} {
+			t.Errorf("[%v]: dst was written: %X", a, out)
+		}
+	}
+
+	hdr := append([]byte{}, in...)
+	binary.LittleEndian.PutUint32(hdr, 4|Blake2s.Version())
+	if err := hs.Compute(hdr, make([]byte, HashSize)); !errors.Is(err, ErrUnsupported) {
+		t.Errorf("Compute: expected: %v, got: %v", ErrUnsupported, err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	hs := New()
+	in, _ := hex.DecodeString(tsInfo[0].in)
+
+	if err := hs.Compute(in, make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+	if err := hs.Compute(in[:HeaderSize-1], make([]byte, HashSize)); err != ErrShortHeader {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortHeader, err)
+	}
+	if _, err := HeaderAlgo(nil); err != ErrShortHeader {
+		t.Errorf("HeaderAlgo: expected: %v, got: %v", ErrShortHeader, err)
+	}
+}
+
+func TestString(t *testing.T) {
+	for a, exp := range map[Algo]string{
+		Scrypt:     "scrypt",
+		MyrGroestl: "myr-groestl",
+		X17:        "x17",
+		Blake2s:    "blake2s",
+		Lyra2REv2:  "lyra2rev2",
+		Algo(7):    "Algo(7)",
+	} {
+		if res := a.String(); res != exp {
+			t.Errorf("String: expected: %s, got: %s", exp, res)
+		}
+	}
+}
+
+////////////////
+
+// Verge mainnet x17 blocks, the same headers the x17 package is
+// tested with.
+var tsInfo = []struct {
+	id   string
+	in   string
+	algo Algo
+	out  string
+}{
+	{
+		"Verge Block",
+		"041800009a04d9dd22efb4c0e322d12260ac1a6168f0d9d6752c4ae7b0337baaa1b1fb512ffcb93e17d818095cd4194a1eb5272b5df34897456a2284ee4fd62aabda4538412a375e9501011b14ebd1a7",
+		X17,
+		"0000000000001626efc6afc18acee83b71fb78b7823d5235279a3138e79b272e",
+	},
+	{
+		"Verge Block2",
+		"04180000e6db0c480eb762feec8f650ce44cfaebe4e6e2f4cecd403f386917df0d3f20871f27d82a01fa39b0f3e7ed2c08d2849a8ef70b04ba707124888bb7d12561a9108dff665d8fa80b1b01a9bc92",
+		X17,
+		"00000000000550a9ba39bf31637c29d318283d1b2e292f0db81d3ac166788a0e",
+	},
+}
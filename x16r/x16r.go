@@ -0,0 +1,203 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+// Package x16r implements the X16R family of hashes, which run sixteen
+// 512 bit digests in an order picked from the block header.
+package x16r
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/rnichollx/go-x17/chain"
+	"github.com/rnichollx/go-x17/hash"
+)
+
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
+// HeaderSize holds the minimum size of a block header in bytes.
+const HeaderSize = int(80)
+
+// TimeMask clears the low bits of the header time before X16RT
+// derives its order, so the order changes about every two minutes.
+const TimeMask = uint32(0xFFFFFF80)
+
+var (
+	// ErrShortDst is returned when dst can not hold HashSize bytes.
+	ErrShortDst = errors.New("x16r: dst shorter than HashSize")
+
+	// ErrShortHeader is returned when src is not a full block header.
+	ErrShortHeader = errors.New("x16r: header shorter than HeaderSize")
+)
+
+////////////////
+
+// Variant selects how the stage order is derived from the header.
+type Variant int
+
+const (
+	// X16R reads the order from the previous block hash.
+	X16R Variant = iota
+	// X16S shuffles the stages using the previous block hash.
+	X16S
+	// X16RT reads the order from a hash of the masked header time.
+	X16RT
+)
+
+// String returns the name of the variant.
+func (v Variant) String() string {
+	switch v {
+	case X16R:
+		return "X16R"
+	case X16S:
+		return "X16S"
+	case X16RT:
+		return "X16RT"
+	}
+	return fmt.Sprintf("Variant(%d)", int(v))
+}
+
+// Order returns the sixteen stages in the order the variant
+// runs them for header.
+func (v Variant) Order(header []byte) ([16]chain.Algo, error) {
+	res := [16]chain.Algo{}
+
+	if len(header) < HeaderSize {
+		return res, ErrShortHeader
+	}
+
+	ord := v.order(header)
+	for i := range ord {
+		res[i] = kAlgo[ord[i]]
+	}
+	return res, nil
+}
+
+////////////////
+
+// Hash contains the state objects required to
+// perform a hash of the X16R family.
+type Hash struct {
+	tha [64]byte
+	thb [64]byte
+
+	variant Variant
+	dgst    [16]hash.Digest
+}
+
+// New returns a new object to compute a hash of the given variant.
+func New(v Variant) *Hash {
+	ref := &Hash{variant: v}
+	for i := range ref.dgst {
+		ref.dgst[i] = kAlgo[i].New()
+	}
+	return ref
+}
+
+// Hash computes the hash of the header held in src and stores the
+// result in dst, errors are dropped and leave dst untouched.
+func (ref *Hash) Hash(src []byte, dst []byte) {
+	ref.Compute(src, dst)
+}
+
+// Compute computes the hash of the header held in src and stores the
+// result in dst, byte swapped like x17.Hash. The order is read from the
+// first HeaderSize bytes of src, all of src is hashed.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+	if len(src) < HeaderSize {
+		return ErrShortHeader
+	}
+
+	ord := ref.variant.order(src)
+	buf := [2][]byte{ref.tha[:], ref.thb[:]}
+
+	ta := src
+	for i := range ord {
+		tb := buf[i&1]
+
+		dgst := ref.dgst[ord[i]]
+		dgst.Reset()
+		dgst.Write(ta)
+		if err := dgst.Close(tb, 0, 0); err != nil {
+			return fmt.Errorf("x16r: stage %v: %w", kAlgo[ord[i]], err)
+		}
+		ta = tb
+	}
+
+	for i := 0; i < HashSize; i++ {
+		dst[i] = ta[HashSize-1-i]
+	}
+	return nil
+}
+
+////////////////
+
+// order returns the stage indexes for header, which
+// must hold at least HeaderSize bytes.
+func (v Variant) order(header []byte) [16]uint8 {
+	switch v {
+	case X16S:
+		return shuffle(header[4:36])
+	case X16RT:
+		th := timeHash(header[68:72])
+		return nibbles(th[:])
+	}
+	return nibbles(header[4:36])
+}
+
+// nibbles reads the last sixteen hex digits of the hash as it is
+// displayed, that is the first eight bytes of h high nibble first
+// starting from the eighth byte.
+func nibbles(h []byte) [16]uint8 {
+	res := [16]uint8{}
+	for j := range res {
+		b := h[(15-j)>>1]
+		if j&1 == 0 {
+			res[j] = b >> 4
+		} else {
+			res[j] = b & 0xF
+		}
+	}
+	return res
+}
+
+// shuffle starts from the natural order and moves the stage at each
+// nibble of the hash to the front.
+func shuffle(h []byte) [16]uint8 {
+	res := [16]uint8{}
+	for i := range res {
+		res[i] = uint8(i)
+	}
+
+	for _, n := range nibbles(h) {
+		v := res[n]
+		copy(res[1:n+1], res[:n])
+		res[0] = v
+	}
+	return res
+}
+
+// timeHash returns the double SHA256 of the masked little endian time.
+func timeHash(tm []byte) [32]byte {
+	buf := [4]byte{}
+	binary.LittleEndian.PutUint32(buf[:], binary.LittleEndian.Uint32(tm)&TimeMask)
+
+	h := sha256.Sum256(buf[:])
+	return sha256.Sum256(h[:])
+}
+
+////////////////
+
+// kAlgo maps the X16R stage indexes to digests.
+var kAlgo = [16]chain.Algo{
+	chain.Blake, chain.BMW, chain.Groestl, chain.JH,
+	chain.Keccak, chain.Skein, chain.Luffa, chain.CubeHash,
+	chain.Shavite, chain.SIMD, chain.Echo, chain.Hamsi,
+	chain.Fugue, chain.Shabal, chain.Whirlpool, chain.SHA512,
+}
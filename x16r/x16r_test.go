@@ -0,0 +1,173 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x16r
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/chain"
+)
+
+func TestHash(t *testing.T) {
+	for _, ts := range tsInfo {
+		hs := New(ts.variant)
+		out := [HashSize]byte{}
+
+		if err := hs.Compute(ts.header(), out[:]); err != nil {
+			t.Fatalf("[%s]: %v", ts.id, err)
+		}
+		if res := hex.EncodeToString(out[:]); res != ts.out {
+			t.Errorf("[%s]: invalid hash \nexpected:	%s, \ngot:		%s", ts.id, ts.out, res)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	header := make([]byte, HeaderSize)
+	for i := range header {
+		header[i] = uint8(i * 37)
+	}
+
+	for _, v := range []Variant{X16R, X16S, X16RT} {
+		ord, err := v.Order(header)
+		if err != nil {
+			t.Fatalf("%v: %v", v, err)
+		}
+
+		ref := chain.NewPow(nil, ord[:]...)
+		exp := [HashSize]byte{}
+		ref.Hash(header, exp[:])
+
+		res := [HashSize]byte{}
+		New(v).Hash(header, res[:])
+		if res != exp {
+			t.Errorf("%v: expected: %X, got: %X", v, exp, res)
+		}
+	}
+}
+
+func TestOrder(t *testing.T) {
+	header := make([]byte, HeaderSize)
+
+	// The previous hash displays as ...0123456789abcdef.
+	copy(header[4:], []byte{0xEF, 0xCD, 0xAB, 0x89, 0x67, 0x45, 0x23, 0x01})
+	ord, _ := X16R.Order(header)
+	if ord != kAlgo {
+		t.Errorf("X16R: expected: %v, got: %v", kAlgo, ord)
+	}
+
+	// Moving 1, 2 and 3 to the front one after the other.
+	copy(header[4:], []byte{0, 0, 0, 0, 0, 0, 0, 0x12})
+	header[10] = 0x30
+	ord, _ = X16S.Order(header)
+	exp := kAlgo
+	exp[0], exp[1], exp[2], exp[3] = kAlgo[3], kAlgo[2], kAlgo[1], kAlgo[0]
+	if ord != exp {
+		t.Errorf("X16S: expected: %v, got: %v", exp, ord)
+	}
+
+	for i := 0; i < 2; i++ {
+		binary.LittleEndian.PutUint32(header[68:], 1560000000+uint32(i*0x7F))
+		ord, _ = X16RT.Order(header)
+
+		tm := [4]byte{}
+		binary.LittleEndian.PutUint32(tm[:], 1560000000&TimeMask)
+		h := sha256.Sum256(tm[:])
+		h = sha256.Sum256(h[:])
+		for j, n := range nibbles(h[:]) {
+			if kAlgo[n] != ord[j] {
+				t.Errorf("X16RT: stage %d expected: %v, got: %v", j, kAlgo[n], ord[j])
+			}
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	hs := New(X16R)
+
+	if err := hs.Compute(make([]byte, HeaderSize-1), make([]byte, HashSize)); err != ErrShortHeader {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortHeader, err)
+	}
+	if err := hs.Compute(make([]byte, HeaderSize), make([]byte, HashSize-1)); err != ErrShortDst {
+		t.Errorf("Compute: expected: %v, got: %v", ErrShortDst, err)
+	}
+	if _, err := X16S.Order(nil); err != ErrShortHeader {
+		t.Errorf("Order: expected: %v, got: %v", ErrShortHeader, err)
+	}
+
+	out := make([]byte, HashSize)
+	hs.Hash(nil, out)
+	if !bytes.Equal(out, make([]byte, HashSize)) {
+		t.Errorf("Hash: expected dst to be left untouched, got: %X", out)
+	}
+}
+
+func TestString(t *testing.T) {
+	for v, exp := range map[Variant]string{
+		X16R: "X16R", X16S: "X16S", X16RT: "X16RT", Variant(7): "Variant(7)",
+	} {
+		if s := v.String(); s != exp {
+			t.Errorf("String: expected: %s, got: %s", exp, s)
+		}
+	}
+}
+
+////////////////
+
+func reverse(src []byte) []byte {
+	res := make([]byte, len(src))
+	for i := range src {
+		res[len(src)-1-i] = src[i]
+	}
+	return res
+}
+
+type header struct {
+	version uint32
+	prev    string
+	merkle  string
+	time    uint32
+	bits    uint32
+	nonce   uint32
+}
+
+func (h header) bytes() []byte {
+	res := make([]byte, HeaderSize)
+	binary.LittleEndian.PutUint32(res[0:], h.version)
+	prev, _ := hex.DecodeString(h.prev)
+	copy(res[4:36], reverse(prev))
+	merkle, _ := hex.DecodeString(h.merkle)
+	copy(res[36:68], reverse(merkle))
+	binary.LittleEndian.PutUint32(res[68:], h.time)
+	binary.LittleEndian.PutUint32(res[72:], h.bits)
+	binary.LittleEndian.PutUint32(res[76:], h.nonce)
+	return res
+}
+
+// Hashes read as block explorers show them, like the output of Compute.
+// The zero previous hash of the Ravencoin genesis runs BLAKE sixteen
+// times, the other stages and orders are covered by TestChain and
+// TestOrder.
+var tsInfo = []struct {
+	id      string
+	variant Variant
+	header  func() []byte
+	out     string
+}{
+	{
+		"Ravencoin genesis",
+		X16R,
+		header{
+			4,
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"28ff00a867739a352523808d301f504bc4547699398d70faf2266a8bae5f3516",
+			1514999494, 0x1e00ffff, 25023712,
+		}.bytes,
+		"0000006b444bc2f2ffe627be9d9e7e7a0730000870ef6eb6da46c8eae389df90",
+	},
+}
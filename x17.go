@@ -6,7 +6,8 @@ package x17
 import (
 	"crypto/sha512"
 	"encoding/binary"
-	"log"
+	stdhash "hash"
+	"time"
 
 	"github.com/rnichollx/go-x17/blake"
 	"github.com/rnichollx/go-x17/bmw"
@@ -27,6 +28,9 @@ import (
 	"github.com/rnichollx/go-x17/whirlpool_x17"
 )
 
+// HashSize holds the size of a hash in bytes.
+const HashSize = int(32)
+
 ////////////////
 
 // Hash contains the state objects
@@ -34,6 +38,7 @@ import (
 type Hash struct {
 	tha [64]byte
 	thb [64]byte
+	thx [128]byte
 
 	le [4]uint64
 
@@ -41,13 +46,23 @@ type Hash struct {
 	bmw     hash.Digest
 	cubed   hash.Digest
 	echo    hash.Digest
+	fugue   hash.Digest
 	groest  hash.Digest
+	hamsi   hash.Digest
 	jhash   hash.Digest
 	keccak  hash.Digest
 	luffa   hash.Digest
+	shabal  hash.Digest
 	shavite hash.Digest
 	simd    hash.Digest
 	skein   hash.Digest
+
+	haval     *haval.Haval256
+	whirlpool stdhash.Hash
+
+	tracing bool
+	tick    time.Time
+	trace   []Stage
 }
 
 // New returns a new object to compute a x17 hash.
@@ -57,80 +72,155 @@ func New() *Hash {
 	ref.bmw = bmw.New()
 	ref.cubed = cubed.New()
 	ref.echo = echo.New()
+	ref.fugue = fugue.New()
 	ref.groest = groest.New()
+	ref.hamsi = hamsi.New()
 	ref.jhash = jhash.New()
 	ref.keccak = keccak.New()
 	ref.luffa = luffa.New()
+	ref.shabal = shabal.New()
 	ref.shavite = shavite.New()
 	ref.simd = simd.New()
 	ref.skein = skein.New()
 
+	ref.haval = haval.New()
+	ref.whirlpool = whirlpool_x17.New()
+
 	return ref
 }
 
 // Hash computes the hash from the src bytes and stores the result in dst.
+// Any data previously passed to Write is discarded. Errors are dropped,
+// in which case dst is left untouched, use Compute to observe them.
 func (ref *Hash) Hash(src []byte, dst []byte) {
-	ta := ref.tha[:]
-	tb := ref.thb[:]
+	ref.Compute(src, dst)
+}
 
-	ref.blake.Write(src)
-	ref.blake.Close(tb, 0, 0)
+// Compute computes the hash from the src bytes and stores the result in dst.
+// Any data previously passed to Write is discarded. A call to Compute with
+// a dst that is smaller then HashSize returns ErrShortDst, a failing stage
+// returns a *StageError.
+func (ref *Hash) Compute(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
 
-	ref.bmw.Write(tb)
-	ref.bmw.Close(ta, 0, 0)
+	ref.blake.Reset()
+	if err := ref.stage("blake", ref.blake, src, ref.thb[:]); err != nil {
+		return err
+	}
 
-	ref.groest.Write(ta)
-	ref.groest.Close(tb, 0, 0)
+	return ref.chain(dst)
+}
 
-	ref.skein.Write(tb)
-	ref.skein.Close(ta, 0, 0)
+// Write adds more data to the running hash, never returns an error.
+// Only the first stage is streamed, the remaining stages run on Sum.
+func (ref *Hash) Write(src []byte) (int, error) {
+	return ref.blake.Write(src)
+}
 
-	ref.jhash.Write(ta)
-	ref.jhash.Close(tb, 0, 0)
+// Sum appends the current hash to dst and returns the result
+// as a slice. It does not change the underlying hash state.
+func (ref *Hash) Sum(dst []byte) []byte {
+	res := [HashSize]byte{}
+	ref.blake.Sum(ref.thb[:0])
+	ref.chain(res[:])
+	return append(dst, res[:]...)
+}
 
-	ref.keccak.Write(tb)
-	ref.keccak.Close(ta, 0, 0)
+// Reset resets the Hash to its initial state.
+func (ref *Hash) Reset() {
+	ref.blake.Reset()
+}
 
-	ref.luffa.Write(ta)
-	ref.luffa.Close(tb, 0, 0)
+// Size returns the number of bytes Sum will return.
+func (*Hash) Size() int {
+	return HashSize
+}
 
-	ref.cubed.Write(tb)
-	ref.cubed.Close(ta, 0, 0)
+// BlockSize returns the block size of the first stage.
+func (ref *Hash) BlockSize() int {
+	return ref.blake.BlockSize()
+}
 
-	ref.shavite.Write(ta)
-	ref.shavite.Close(tb, 0, 0)
+////////////////
 
-	ref.simd.Write(tb)
-	ref.simd.Close(ta, 0, 0)
+// chain runs the stages after BLAKE512 on the digest held in thb
+// and stores the byte swapped result in dst.
+func (ref *Hash) chain(dst []byte) error {
+	stages := ref.digests()
+
+	ta := ref.thb[:]
+	tb := ref.tha[:]
+	for i := range stages {
+		if err := ref.stage(stages[i].name, stages[i].dgst, ta, tb); err != nil {
+			return err
+		}
+		ta, tb = tb, ta
+	}
 
-	ref.echo.Write(ta)
-	ref.echo.Close(tb, 0, 0)
+	ref.whirlpool.Reset()
+	ref.whirlpool.Write(ta)
+	tb = ref.whirlpool.Sum(tb[:0])
+	ref.mark("whirlpool", tb)
 
-	hamsi.SumBig(tb, ta[:])
+	sum := sha512.Sum512(tb)
+	copy(ta, sum[:])
+	ref.mark("sha512", ta)
 
-	fugue.SumBig(ta, tb[:])
+	ref.haval.Update(ta, 0, len(ta))
+	if err := ref.haval.Close(tb); err != nil {
+		return &StageError{Stage: "haval", Err: err}
+	}
+	tb = tb[:HashSize]
+	ref.mark("haval", tb)
 
-	shabal.SumBig(tb, ta[:])
+	if err := ref.convert32BytesToBE(tb); err != nil {
+		return err
+	}
+	copy(dst, tb)
+	return nil
+}
 
-	whirlpool := whirlpool_x17.New()
-	whirlpool.Write(ta)
-	tb = whirlpool.Sum(nil)
+// namedDigest pairs a stage digest with its trace name.
+type namedDigest struct {
+	name string
+	dgst hash.Digest
+}
 
-	sha512Hash := sha512.Sum512(tb)
-	ta = sha512Hash[:]
+// digests returns the 512 bit stages from BMW512 to SHABAL512 in order.
+func (ref *Hash) digests() [13]namedDigest {
+	return [13]namedDigest{
+		{"bmw", ref.bmw},
+		{"groestl", ref.groest},
+		{"skein", ref.skein},
+		{"jh", ref.jhash},
+		{"keccak", ref.keccak},
+		{"luffa", ref.luffa},
+		{"cubehash", ref.cubed},
+		{"shavite", ref.shavite},
+		{"simd", ref.simd},
+		{"echo", ref.echo},
+		{"hamsi", ref.hamsi},
+		{"fugue", ref.fugue},
+		{"shabal", ref.shabal},
+	}
+}
 
-	haval256Hash := haval.New()
-	haval256Hash.Update(ta, 0, len(ta))
-	tb = haval256Hash.Digest()
+// stage feeds src to dgst and closes it into dst.
+func (ref *Hash) stage(name string, dgst hash.Digest, src, dst []byte) error {
+	dgst.Write(src)
+	if err := dgst.Close(dst, 0, 0); err != nil {
+		return &StageError{Stage: name, Err: err}
+	}
 
-	ref.convert32BytesToBE(tb)
-	copy(dst, tb)
+	ref.mark(name, dst)
+	return nil
 }
 
-func (ref *Hash) convert32BytesToBE(hashedBytes []byte) {
-
+func (ref *Hash) convert32BytesToBE(hashedBytes []byte) error {
 	if len(hashedBytes) < 32 {
-		log.Fatal("Expected at least 32 bytes to be converted into big endian.")
+		return ErrShortDigest
 	}
 
 	ref.le[0] = binary.LittleEndian.Uint64(hashedBytes[0:8])
@@ -141,4 +231,5 @@ func (ref *Hash) convert32BytesToBE(hashedBytes []byte) {
 	for i := 0; i < len(ref.le); i++ {
 		binary.BigEndian.PutUint64(hashedBytes[i*8:(i+1)*8], ref.le[len(ref.le)-1-i])
 	}
+	return nil
 }
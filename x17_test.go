@@ -5,7 +5,10 @@ package x17
 
 import (
 	"bytes"
+	"crypto/hmac"
 	"encoding/hex"
+	stdhash "hash"
+	"io"
 	"testing"
 )
 
@@ -28,6 +31,98 @@ func TestHash(t *testing.T) {
 	}
 }
 
+func TestHashAllocs(t *testing.T) {
+	hs := New()
+	out17 := [32]byte{}
+
+	allocs := testing.AllocsPerRun(16, func() {
+		hs.Hash(hexBlockVerge, out17[:])
+	})
+	if allocs != 0 {
+		t.Errorf("Hash: expected 0 allocations, got: %v", allocs)
+	}
+}
+
+func BenchmarkHash(b *testing.B) {
+	hs := New()
+	out17 := [32]byte{}
+
+	b.ReportAllocs()
+	b.SetBytes(int64(len(hexBlockVerge)))
+	for i := 0; i < b.N; i++ {
+		hs.Hash(hexBlockVerge, out17[:])
+	}
+}
+
+func TestApi(t *testing.T) {
+	hs := New()
+	if sz := hs.Size(); HashSize != sz {
+		t.Errorf("Size: expected: %d, got: %d", HashSize, sz)
+	}
+	if sz := hs.BlockSize(); 128 != sz {
+		t.Errorf("BlockSize: expected: %d, got: %d", 128, sz)
+	}
+}
+
+func TestStream(t *testing.T) {
+	hs := New()
+
+	for i := range tsInfo {
+		for step := 1; step <= len(tsInfo[i].in)+1; step += 7 {
+			hs.Reset()
+
+			src := tsInfo[i].in
+			for len(src) > step {
+				hs.Write(src[:step])
+				src = src[step:]
+			}
+			hs.Write(src)
+
+			dest := make([]byte, hex.EncodedLen(HashSize))
+			hex.Encode(dest, hs.Sum(nil))
+
+			if !bytes.Equal(dest, tsInfo[i].out17) {
+				t.Errorf("[%s-x17] step %d: invalid hash \nexpected:	%64s, \ngot:		%64s", tsInfo[i].id, step, tsInfo[i].out17, dest)
+			}
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	hs := New()
+	io.Copy(hs, bytes.NewReader(hexBlockVerge))
+
+	res := hs.Sum([]byte{0xFF})
+	if len(res) != HashSize+1 || res[0] != 0xFF {
+		t.Fatalf("Sum: expected dst prefix and %d bytes, got: %X", HashSize, res)
+	}
+	if again := hs.Sum(nil); !bytes.Equal(res[1:], again) {
+		t.Errorf("Sum: changed state \nexpected:	%X, \ngot:		%X", res[1:], again)
+	}
+
+	out17 := [32]byte{}
+	hs.Hash(hexBlockVerge, out17[:])
+	if !bytes.Equal(res[1:], out17[:]) {
+		t.Errorf("Sum: differs from Hash \nexpected:	%X, \ngot:		%X", out17[:], res[1:])
+	}
+}
+
+func TestHmac(t *testing.T) {
+	key := []byte("XVG")
+
+	mac := hmac.New(func() stdhash.Hash { return New() }, key)
+	mac.Write(hexBlockVerge)
+	res := mac.Sum(nil)
+
+	mac.Reset()
+	mac.Write(hexBlockVerge[:40])
+	mac.Write(hexBlockVerge[40:])
+
+	if again := mac.Sum(nil); !hmac.Equal(res, again) {
+		t.Errorf("HMAC: expected: %X, got: %X", res, again)
+	}
+}
+
 ////////////////
 
 var hexBlockVerge, _ = hex.DecodeString("041800009a04d9dd22efb4c0e322d12260ac1a6168f0d9d6752c4ae7b0337baaa1b1fb512ffcb93e17d818095cd4194a1eb5272b5df34897456a2284ee4fd62aabda4538412a375e9501011b14ebd1a7")
@@ -0,0 +1,72 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import "crypto/sha512"
+
+////////////////
+
+// Xevan computes the XEVAN hash from the src bytes and stores the result
+// in dst. XEVAN runs the x17 stages twice, every stage but the very first
+// hashes 128 bytes: the output of its predecessor zero extended. Like
+// Hash the result is byte swapped, so it reads as block explorers show
+// it. A call to Xevan with a dst that is smaller then HashSize returns
+// ErrShortDst, a failing stage returns a *StageError. Any data previously
+// passed to Write is discarded.
+func (ref *Hash) Xevan(src []byte, dst []byte) error {
+	if ln := len(dst); HashSize > ln {
+		return ErrShortDst
+	}
+
+	buf := ref.thx[:]
+	memset(buf, 0)
+
+	ref.blake.Reset()
+	if err := ref.stage("blake", ref.blake, src, buf[:64]); err != nil {
+		return err
+	}
+
+	for pass := 0; pass < 2; pass++ {
+		if pass > 0 {
+			if err := ref.stage("blake", ref.blake, buf, buf[:64]); err != nil {
+				return err
+			}
+		}
+
+		stages := ref.digests()
+		for i := range stages {
+			if err := ref.stage(stages[i].name, stages[i].dgst, buf, buf[:64]); err != nil {
+				return err
+			}
+		}
+
+		ref.whirlpool.Reset()
+		ref.whirlpool.Write(buf)
+		ref.whirlpool.Sum(buf[:0])
+		ref.mark("whirlpool", buf[:64])
+
+		sum := sha512.Sum512(buf)
+		copy(buf, sum[:])
+		ref.mark("sha512", buf[:64])
+
+		ref.haval.Update(buf, 0, len(buf))
+		if err := ref.haval.Close(buf); err != nil {
+			return &StageError{Stage: "haval", Err: err}
+		}
+		memset(buf[HashSize:], 0)
+		ref.mark("haval", buf[:HashSize])
+	}
+
+	if err := ref.convert32BytesToBE(buf[:HashSize]); err != nil {
+		return err
+	}
+	copy(dst, buf[:HashSize])
+	return nil
+}
+
+func memset(dst []byte, src byte) {
+	for i := range dst {
+		dst[i] = src
+	}
+}
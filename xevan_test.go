@@ -0,0 +1,112 @@
+// Use of this source code is governed by an ISC
+// license that can be found in the LICENSE file.
+
+package x17
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"testing"
+
+	"github.com/rnichollx/go-x17/chain"
+	"github.com/rnichollx/go-x17/haval"
+)
+
+func TestXevan(t *testing.T) {
+	hs := New()
+
+	out := [HashSize]byte{}
+	for i := range tsXevan {
+		if err := hs.Xevan([]byte(tsXevan[i].in), out[:]); err != nil {
+			t.Fatalf("[%s-xevan]: %v", tsXevan[i].id, err)
+		}
+		if res := hex.EncodeToString(out[:]); res != tsXevan[i].out {
+			t.Errorf("[%s-xevan]: invalid hash \nexpected:	%s, \ngot:		%s", tsXevan[i].id, tsXevan[i].out, res)
+		}
+	}
+
+	if err := hs.Xevan(nil, out[:HashSize-1]); err != ErrShortDst {
+		t.Errorf("Xevan: expected: %v, got: %v", ErrShortDst, err)
+	}
+}
+
+// TestXevanCompose checks Xevan against fresh digests
+// fed with explicitly zero extended buffers.
+func TestXevanCompose(t *testing.T) {
+	hs := New()
+
+	for i := range tsXevan {
+		in := []byte(tsXevan[i].in)
+		buf := make([]byte, 128)
+
+		sum := func(a chain.Algo, src []byte) {
+			dgst := a.New()
+			dgst.Write(src)
+			copy(buf, dgst.Sum(nil))
+		}
+
+		sum(chain.Blake, in)
+		for pass := 0; pass < 2; pass++ {
+			if pass > 0 {
+				sum(chain.Blake, buf)
+			}
+			for _, a := range chain.X15()[1:] {
+				sum(a, buf)
+			}
+
+			h := sha512.Sum512(buf)
+			copy(buf, h[:])
+
+			hv := haval.New()
+			hv.Update(buf, 0, len(buf))
+			copy(buf, append(hv.Digest(), make([]byte, 96)...))
+		}
+		for l, r := 0, HashSize-1; l < r; l, r = l+1, r-1 {
+			buf[l], buf[r] = buf[r], buf[l]
+		}
+
+		res := [HashSize]byte{}
+		hs.Xevan(in, res[:])
+		if !bytes.Equal(buf[:HashSize], res[:]) {
+			t.Errorf("[%s-xevan]: invalid hash \nexpected:	%X, \ngot:		%X", tsXevan[i].id, buf[:HashSize], res)
+		}
+	}
+}
+
+func TestXevanAllocs(t *testing.T) {
+	hs := New()
+	out := [HashSize]byte{}
+
+	allocs := testing.AllocsPerRun(16, func() {
+		hs.Xevan(hexBlockVerge, out[:])
+	})
+	if allocs != 0 {
+		t.Errorf("Xevan: expected 0 allocations, got: %v", allocs)
+	}
+}
+
+// Output of this package, byte swapped like Hash. They pin the current
+// result until a BitSend or Elite header can confirm XEVAN and its byte
+// order.
+var tsXevan = []struct {
+	id  string
+	in  string
+	out string
+}{
+	{
+		"Empty",
+		"",
+		"b3c4d6d629be1849fbb5012b3cd9e01a487dcdd817b3ee7369b39e44c956264b",
+	},
+	{
+		"XVG",
+		"XVG",
+		"6fa4eb906498eca69f926d2351ee7a75e3e3b0ae33487925fcab1e476e46a20c",
+	},
+	{
+		"Fox",
+		"The quick brown fox jumps over the lazy dog",
+		"63049293aab379151126e4c2a4aa090b5f3d7edfb4ed7b248eafe9e4a0d937fe",
+	},
+}